@@ -6,8 +6,11 @@ package fileanalysis
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,108 +30,2152 @@ const (
 	ApiErrorErrorValidationError ApiErrorError = "validation_error"
 )
 
+// Defines values for PolicyAlgorithm.
+const (
+	Shingle   PolicyAlgorithm = "shingle"
+	Winnowing PolicyAlgorithm = "winnowing"
+)
+
 // Defines values for ReportStatus.
 const (
-	Completed ReportStatus = "completed"
-	Failed    ReportStatus = "failed"
+	Completed  ReportStatus = "completed"
+	Failed     ReportStatus = "failed"
+	Pending    ReportStatus = "pending"
+	Processing ReportStatus = "processing"
 )
 
-// AnalysisRequest defines model for AnalysisRequest.
-type AnalysisRequest struct {
-	// AssignmentId Assignment identifier
-	AssignmentId *string `json:"assignment_id,omitempty"`
+// AnalysisRequest defines model for AnalysisRequest.
+type AnalysisRequest struct {
+	// AssignmentId Assignment identifier
+	AssignmentId *string `json:"assignment_id,omitempty"`
+
+	// FileId File identifier to analyze
+	FileId string `json:"file_id"`
+
+	// FileIds All files of a multi-file submission. One report covers all of them:
+	// every file is compared separately and plagiarism_score is their
+	// word-weighted average. file_id is added if missing.
+	FileIds *[]string `json:"file_ids,omitempty"`
+
+	// StudentId Student identifier
+	StudentId *string `json:"student_id,omitempty"`
+
+	// WorkId Work identifier
+	WorkId string `json:"work_id"`
+}
+
+// ApiError defines model for ApiError.
+type ApiError struct {
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Error   *ApiErrorError          `json:"error,omitempty"`
+	Message *string                 `json:"message,omitempty"`
+}
+
+// ApiErrorError defines model for ApiError.Error.
+type ApiErrorError string
+
+// MatchedFragment defines model for MatchedFragment.
+type MatchedFragment struct {
+	Original *TextSpan `json:"original,omitempty"`
+	Similar  *TextSpan `json:"similar,omitempty"`
+}
+
+// Policy defines model for Policy.
+type Policy struct {
+	// Algorithm shingle - compare all shingles of the works,
+	// winnowing - compare only winnowing fingerprints (faster, less precise)
+	Algorithm *PolicyAlgorithm `json:"algorithm,omitempty"`
+
+	// AssignmentId Assignment identifier; taken from the path on update
+	AssignmentId *string `json:"assignment_id,omitempty"`
+
+	// CompareAllRevisions Compare with every revision of other students' works. By default
+	// only their current revisions are compared.
+	CompareAllRevisions *bool      `json:"compare_all_revisions,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+
+	// IgnoreTemplateCode Exclude matches with the assignment template files
+	IgnoreTemplateCode *bool `json:"ignore_template_code,omitempty"`
+
+	// MinMatchLength Minimum length of a match in tokens (words or code tokens)
+	MinMatchLength *int `json:"min_match_length,omitempty"`
+
+	// MinSimilarityPercentage Minimum similarity for a work to be listed in similar_works
+	MinSimilarityPercentage *float32 `json:"min_similarity_percentage,omitempty"`
+
+	// PlagiarismThreshold Score above which a work is marked as plagiarism
+	PlagiarismThreshold *float32   `json:"plagiarism_threshold,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// PolicyAlgorithm shingle - compare all shingles of the works,
+// winnowing - compare only winnowing fingerprints (faster, less precise)
+type PolicyAlgorithm string
+
+// Report defines model for Report.
+type Report struct {
+	AnalysisDurationMs *int `json:"analysis_duration_ms,omitempty"`
+
+	// AnalysisMode How the file was tokenized: "text" for prose or "code:<language>"
+	// for source code (comments stripped, identifiers and literals normalized).
+	// "archive" for ZIP/TAR submissions and "multi-file" for submissions of
+	// several files: every file is compared separately and
+	// plagiarism_score is their word-weighted average
+	AnalysisMode *string    `json:"analysis_mode,omitempty"`
+	AssignmentId *string    `json:"assignment_id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+
+	// ErrorMessage Error message if status is failed
+	ErrorMessage *string `json:"error_message,omitempty"`
+	FileId       *string `json:"file_id,omitempty"`
+
+	// FileIds Files covered by the report if the work has several, file_id first
+	FileIds *[]string `json:"file_ids,omitempty"`
+
+	// IsLatest True for the most recent analysis version of the file
+	IsLatest *bool `json:"is_latest,omitempty"`
+
+	// IsPlagiarism True if plagiarism detected (based on threshold)
+	IsPlagiarism *bool `json:"is_plagiarism,omitempty"`
+
+	// PlagiarismScore Percentage of plagiarism detected (0-100)
+	PlagiarismScore *float32 `json:"plagiarism_score,omitempty"`
+	ReportId        *string  `json:"report_id,omitempty"`
+
+	// ResubmissionOf Set when the file is a resubmission: id of the same student's earlier
+	// file it matches best. Own earlier files are listed in similar_works
+	// with is_self_match and are not counted in plagiarism_score
+	ResubmissionOf *string `json:"resubmission_of,omitempty"`
+
+	// SimilarWorks List of similar works found
+	SimilarWorks *[]SimilarWork `json:"similar_works,omitempty"`
+
+	// Status pending - queued for analysis, processing - analysis in progress,
+	// completed - analysis results are available, failed - see error_message
+	Status    *ReportStatus `json:"status,omitempty"`
+	StudentId *string       `json:"student_id,omitempty"`
+
+	// Version Analysis version of the file; re-analysis creates a new version
+	Version   *int    `json:"version,omitempty"`
+	WordCount *int    `json:"word_count,omitempty"`
+	WorkId    *string `json:"work_id,omitempty"`
+}
+
+// ReportStatus pending - queued for analysis, processing - analysis in progress,
+// completed - analysis results are available, failed - see error_message
+type ReportStatus string
+
+// SimilarWork defines model for SimilarWork.
+type SimilarWork struct {
+	// Fragments Matched regions in the analyzed file and in the similar file
+	Fragments *[]MatchedFragment `json:"fragments,omitempty"`
+
+	// IsSelfMatch The similar file is the same student's earlier submission
+	IsSelfMatch *bool `json:"is_self_match,omitempty"`
+
+	// OriginalPath Path of the matching file inside the analyzed archive
+	OriginalPath *string `json:"original_path,omitempty"`
+
+	// SimilarPath Path of the matched file inside the similar work's archive
+	SimilarPath          *string  `json:"similar_path,omitempty"`
+	SimilarityPercentage *float32 `json:"similarity_percentage,omitempty"`
+	StudentId            *string  `json:"student_id,omitempty"`
+	WorkId               *string  `json:"work_id,omitempty"`
+}
+
+// TextSpan defines model for TextSpan.
+type TextSpan struct {
+	EndLine *int `json:"end_line,omitempty"`
+
+	// Length Fragment length in bytes
+	Length *int `json:"length,omitempty"`
+
+	// Offset Byte offset of the fragment start
+	Offset    *int `json:"offset,omitempty"`
+	StartLine *int `json:"start_line,omitempty"`
+}
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ApiError
+
+// Conflict defines model for Conflict.
+type Conflict = ApiError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ApiError
+
+// NotFound defines model for NotFound.
+type NotFound = ApiError
+
+// ListReportsParams defines parameters for ListReports.
+type ListReportsParams struct {
+	WorkId       *string `form:"work_id,omitempty" json:"work_id,omitempty"`
+	FileId       *string `form:"file_id,omitempty" json:"file_id,omitempty"`
+	AssignmentId *string `form:"assignment_id,omitempty" json:"assignment_id,omitempty"`
+	StudentId    *string `form:"student_id,omitempty" json:"student_id,omitempty"`
+	Limit        *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset       *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AnalyzeFileJSONRequestBody defines body for AnalyzeFile for application/json ContentType.
+type AnalyzeFileJSONRequestBody = AnalysisRequest
+
+// CreatePolicyJSONRequestBody defines body for CreatePolicy for application/json ContentType.
+type CreatePolicyJSONRequestBody = Policy
+
+// UpdatePolicyJSONRequestBody defines body for UpdatePolicy for application/json ContentType.
+type UpdatePolicyJSONRequestBody = Policy
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AnalyzeFileWithBody request with any body
+	AnalyzeFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnalyzeFile(ctx context.Context, body AnalyzeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReanalyzeAssignment request
+	ReanalyzeAssignment(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPolicies request
+	ListPolicies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePolicyWithBody request with any body
+	CreatePolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePolicy(ctx context.Context, body CreatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePolicy request
+	DeletePolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPolicy request
+	GetPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePolicyWithBody request with any body
+	UpdatePolicyWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePolicy(ctx context.Context, assignmentId string, body UpdatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReports request
+	ListReports(ctx context.Context, params *ListReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkReports request
+	GetWorkReports(ctx context.Context, workId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteReport request
+	DeleteReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReport request
+	GetReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReanalyzeReport request
+	ReanalyzeReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReportDiff request
+	GetReportDiff(ctx context.Context, reportId string, similarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AnalyzeFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeFileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnalyzeFile(ctx context.Context, body AnalyzeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeFileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReanalyzeAssignment(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReanalyzeAssignmentRequest(c.Server, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPolicies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPoliciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePolicy(ctx context.Context, body CreatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePolicyRequest(c.Server, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPolicyRequest(c.Server, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePolicyWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyRequestWithBody(c.Server, assignmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePolicy(ctx context.Context, assignmentId string, body UpdatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyRequest(c.Server, assignmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListReports(ctx context.Context, params *ListReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReportsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkReports(ctx context.Context, workId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkReportsRequest(c.Server, workId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteReportRequest(c.Server, reportId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportRequest(c.Server, reportId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReanalyzeReport(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReanalyzeReportRequest(c.Server, reportId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReportDiff(ctx context.Context, reportId string, similarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportDiffRequest(c.Server, reportId, similarId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAnalyzeFileRequest calls the generic AnalyzeFile builder with application/json body
+func NewAnalyzeFileRequest(server string, body AnalyzeFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAnalyzeFileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAnalyzeFileRequestWithBody generates requests for AnalyzeFile with any type of body
+func NewAnalyzeFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analyze")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReanalyzeAssignmentRequest generates requests for ReanalyzeAssignment
+func NewReanalyzeAssignmentRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/reanalyze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPoliciesRequest generates requests for ListPolicies
+func NewListPoliciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePolicyRequest calls the generic CreatePolicy builder with application/json body
+func NewCreatePolicyRequest(server string, body CreatePolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePolicyRequestWithBody generates requests for CreatePolicy with any type of body
+func NewCreatePolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePolicyRequest generates requests for DeletePolicy
+func NewDeletePolicyRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPolicyRequest generates requests for GetPolicy
+func NewGetPolicyRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePolicyRequest calls the generic UpdatePolicy builder with application/json body
+func NewUpdatePolicyRequest(server string, assignmentId string, body UpdatePolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePolicyRequestWithBody(server, assignmentId, "application/json", bodyReader)
+}
+
+// NewUpdatePolicyRequestWithBody generates requests for UpdatePolicy with any type of body
+func NewUpdatePolicyRequestWithBody(server string, assignmentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListReportsRequest generates requests for ListReports
+func NewListReportsRequest(server string, params *ListReportsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WorkId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "work_id", runtime.ParamLocationQuery, *params.WorkId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FileId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "file_id", runtime.ParamLocationQuery, *params.FileId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AssignmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assignment_id", runtime.ParamLocationQuery, *params.AssignmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StudentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "student_id", runtime.ParamLocationQuery, *params.StudentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkReportsRequest generates requests for GetWorkReports
+func NewGetWorkReportsRequest(server string, workId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/work/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteReportRequest generates requests for DeleteReport
+func NewDeleteReportRequest(server string, reportId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "report_id", runtime.ParamLocationPath, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReportRequest generates requests for GetReport
+func NewGetReportRequest(server string, reportId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "report_id", runtime.ParamLocationPath, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReanalyzeReportRequest generates requests for ReanalyzeReport
+func NewReanalyzeReportRequest(server string, reportId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "report_id", runtime.ParamLocationPath, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/%s/reanalyze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReportDiffRequest generates requests for GetReportDiff
+func NewGetReportDiffRequest(server string, reportId string, similarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "report_id", runtime.ParamLocationPath, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "similar_id", runtime.ParamLocationPath, similarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/%s/similar/%s/diff", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AnalyzeFileWithBodyWithResponse request with any body
+	AnalyzeFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeFileResponse, error)
+
+	AnalyzeFileWithResponse(ctx context.Context, body AnalyzeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeFileResponse, error)
+
+	// ReanalyzeAssignmentWithResponse request
+	ReanalyzeAssignmentWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*ReanalyzeAssignmentResponse, error)
+
+	// ListPoliciesWithResponse request
+	ListPoliciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPoliciesResponse, error)
+
+	// CreatePolicyWithBodyWithResponse request with any body
+	CreatePolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePolicyResponse, error)
+
+	CreatePolicyWithResponse(ctx context.Context, body CreatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePolicyResponse, error)
+
+	// DeletePolicyWithResponse request
+	DeletePolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*DeletePolicyResponse, error)
+
+	// GetPolicyWithResponse request
+	GetPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*GetPolicyResponse, error)
+
+	// UpdatePolicyWithBodyWithResponse request with any body
+	UpdatePolicyWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyResponse, error)
+
+	UpdatePolicyWithResponse(ctx context.Context, assignmentId string, body UpdatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyResponse, error)
+
+	// ListReportsWithResponse request
+	ListReportsWithResponse(ctx context.Context, params *ListReportsParams, reqEditors ...RequestEditorFn) (*ListReportsResponse, error)
+
+	// GetWorkReportsWithResponse request
+	GetWorkReportsWithResponse(ctx context.Context, workId string, reqEditors ...RequestEditorFn) (*GetWorkReportsResponse, error)
+
+	// DeleteReportWithResponse request
+	DeleteReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*DeleteReportResponse, error)
+
+	// GetReportWithResponse request
+	GetReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*GetReportResponse, error)
+
+	// ReanalyzeReportWithResponse request
+	ReanalyzeReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*ReanalyzeReportResponse, error)
+
+	// GetReportDiffWithResponse request
+	GetReportDiffWithResponse(ctx context.Context, reportId string, similarId string, reqEditors ...RequestEditorFn) (*GetReportDiffResponse, error)
+}
+
+type AnalyzeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Report
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AnalyzeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnalyzeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReanalyzeAssignmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		Count   *int      `json:"count,omitempty"`
+		Reports *[]Report `json:"reports,omitempty"`
+	}
+	JSON404 *NotFound
+	JSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReanalyzeAssignmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReanalyzeAssignmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count    *int      `json:"count,omitempty"`
+		Policies *[]Policy `json:"policies,omitempty"`
+	}
+	JSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Policy
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Limit   *int      `json:"limit,omitempty"`
+		Offset  *int      `json:"offset,omitempty"`
+		Reports *[]Report `json:"reports,omitempty"`
+		Total   *int      `json:"total,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Report
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *ApiError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Report
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReanalyzeReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Report
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReanalyzeReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReanalyzeReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AnalyzeFileWithBodyWithResponse request with arbitrary body returning *AnalyzeFileResponse
+func (c *ClientWithResponses) AnalyzeFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeFileResponse, error) {
+	rsp, err := c.AnalyzeFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnalyzeFileWithResponse(ctx context.Context, body AnalyzeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeFileResponse, error) {
+	rsp, err := c.AnalyzeFile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeFileResponse(rsp)
+}
+
+// ReanalyzeAssignmentWithResponse request returning *ReanalyzeAssignmentResponse
+func (c *ClientWithResponses) ReanalyzeAssignmentWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*ReanalyzeAssignmentResponse, error) {
+	rsp, err := c.ReanalyzeAssignment(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReanalyzeAssignmentResponse(rsp)
+}
+
+// ListPoliciesWithResponse request returning *ListPoliciesResponse
+func (c *ClientWithResponses) ListPoliciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPoliciesResponse, error) {
+	rsp, err := c.ListPolicies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPoliciesResponse(rsp)
+}
+
+// CreatePolicyWithBodyWithResponse request with arbitrary body returning *CreatePolicyResponse
+func (c *ClientWithResponses) CreatePolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePolicyResponse, error) {
+	rsp, err := c.CreatePolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePolicyWithResponse(ctx context.Context, body CreatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePolicyResponse, error) {
+	rsp, err := c.CreatePolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePolicyResponse(rsp)
+}
+
+// DeletePolicyWithResponse request returning *DeletePolicyResponse
+func (c *ClientWithResponses) DeletePolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*DeletePolicyResponse, error) {
+	rsp, err := c.DeletePolicy(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePolicyResponse(rsp)
+}
+
+// GetPolicyWithResponse request returning *GetPolicyResponse
+func (c *ClientWithResponses) GetPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*GetPolicyResponse, error) {
+	rsp, err := c.GetPolicy(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPolicyResponse(rsp)
+}
+
+// UpdatePolicyWithBodyWithResponse request with arbitrary body returning *UpdatePolicyResponse
+func (c *ClientWithResponses) UpdatePolicyWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyResponse, error) {
+	rsp, err := c.UpdatePolicyWithBody(ctx, assignmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePolicyWithResponse(ctx context.Context, assignmentId string, body UpdatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyResponse, error) {
+	rsp, err := c.UpdatePolicy(ctx, assignmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyResponse(rsp)
+}
+
+// ListReportsWithResponse request returning *ListReportsResponse
+func (c *ClientWithResponses) ListReportsWithResponse(ctx context.Context, params *ListReportsParams, reqEditors ...RequestEditorFn) (*ListReportsResponse, error) {
+	rsp, err := c.ListReports(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReportsResponse(rsp)
+}
+
+// GetWorkReportsWithResponse request returning *GetWorkReportsResponse
+func (c *ClientWithResponses) GetWorkReportsWithResponse(ctx context.Context, workId string, reqEditors ...RequestEditorFn) (*GetWorkReportsResponse, error) {
+	rsp, err := c.GetWorkReports(ctx, workId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkReportsResponse(rsp)
+}
+
+// DeleteReportWithResponse request returning *DeleteReportResponse
+func (c *ClientWithResponses) DeleteReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*DeleteReportResponse, error) {
+	rsp, err := c.DeleteReport(ctx, reportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteReportResponse(rsp)
+}
+
+// GetReportWithResponse request returning *GetReportResponse
+func (c *ClientWithResponses) GetReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*GetReportResponse, error) {
+	rsp, err := c.GetReport(ctx, reportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportResponse(rsp)
+}
+
+// ReanalyzeReportWithResponse request returning *ReanalyzeReportResponse
+func (c *ClientWithResponses) ReanalyzeReportWithResponse(ctx context.Context, reportId string, reqEditors ...RequestEditorFn) (*ReanalyzeReportResponse, error) {
+	rsp, err := c.ReanalyzeReport(ctx, reportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReanalyzeReportResponse(rsp)
+}
+
+// GetReportDiffWithResponse request returning *GetReportDiffResponse
+func (c *ClientWithResponses) GetReportDiffWithResponse(ctx context.Context, reportId string, similarId string, reqEditors ...RequestEditorFn) (*GetReportDiffResponse, error) {
+	rsp, err := c.GetReportDiff(ctx, reportId, similarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportDiffResponse(rsp)
+}
+
+// ParseAnalyzeFileResponse parses an HTTP response from a AnalyzeFileWithResponse call
+func ParseAnalyzeFileResponse(rsp *http.Response) (*AnalyzeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnalyzeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Report
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReanalyzeAssignmentResponse parses an HTTP response from a ReanalyzeAssignmentWithResponse call
+func ParseReanalyzeAssignmentResponse(rsp *http.Response) (*ReanalyzeAssignmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReanalyzeAssignmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Count   *int      `json:"count,omitempty"`
+			Reports *[]Report `json:"reports,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPoliciesResponse parses an HTTP response from a ListPoliciesWithResponse call
+func ParseListPoliciesResponse(rsp *http.Response) (*ListPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count    *int      `json:"count,omitempty"`
+			Policies *[]Policy `json:"policies,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePolicyResponse parses an HTTP response from a CreatePolicyWithResponse call
+func ParseCreatePolicyResponse(rsp *http.Response) (*CreatePolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	// FileId File identifier to analyze
-	FileId string `json:"file_id"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	// StudentId Student identifier
-	StudentId *string `json:"student_id,omitempty"`
+	}
 
-	// WorkId Work identifier
-	WorkId string `json:"work_id"`
+	return response, nil
 }
 
-// ApiError defines model for ApiError.
-type ApiError struct {
-	Details *map[string]interface{} `json:"details,omitempty"`
-	Error   *ApiErrorError          `json:"error,omitempty"`
-	Message *string                 `json:"message,omitempty"`
+// ParseDeletePolicyResponse parses an HTTP response from a DeletePolicyWithResponse call
+func ParseDeletePolicyResponse(rsp *http.Response) (*DeletePolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ApiErrorError defines model for ApiError.Error.
-type ApiErrorError string
+// ParseGetPolicyResponse parses an HTTP response from a GetPolicyWithResponse call
+func ParseGetPolicyResponse(rsp *http.Response) (*GetPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Report defines model for Report.
-type Report struct {
-	AnalysisDurationMs *int       `json:"analysis_duration_ms,omitempty"`
-	AssignmentId       *string    `json:"assignment_id,omitempty"`
-	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	response := &GetPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// ErrorMessage Error message if status is failed
-	ErrorMessage *string `json:"error_message,omitempty"`
-	FileId       *string `json:"file_id,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// IsPlagiarism True if plagiarism detected (based on threshold)
-	IsPlagiarism *bool `json:"is_plagiarism,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	// PlagiarismScore Percentage of plagiarism detected (0-100)
-	PlagiarismScore *float32 `json:"plagiarism_score,omitempty"`
-	ReportId        *string  `json:"report_id,omitempty"`
+	}
 
-	// SimilarWorks List of similar works found
-	SimilarWorks *[]SimilarWork `json:"similar_works,omitempty"`
-	Status       *ReportStatus  `json:"status,omitempty"`
-	StudentId    *string        `json:"student_id,omitempty"`
-	WordCount    *int           `json:"word_count,omitempty"`
-	WorkId       *string        `json:"work_id,omitempty"`
+	return response, nil
 }
 
-// ReportStatus defines model for Report.Status.
-type ReportStatus string
+// ParseUpdatePolicyResponse parses an HTTP response from a UpdatePolicyWithResponse call
+func ParseUpdatePolicyResponse(rsp *http.Response) (*UpdatePolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// SimilarWork defines model for SimilarWork.
-type SimilarWork struct {
-	SimilarityPercentage *float32 `json:"similarity_percentage,omitempty"`
-	StudentId            *string  `json:"student_id,omitempty"`
-	WorkId               *string  `json:"work_id,omitempty"`
+	response := &UpdatePolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// BadRequest defines model for BadRequest.
-type BadRequest = ApiError
+// ParseListReportsResponse parses an HTTP response from a ListReportsWithResponse call
+func ParseListReportsResponse(rsp *http.Response) (*ListReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError = ApiError
+	response := &ListReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// NotFound defines model for NotFound.
-type NotFound = ApiError
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Limit   *int      `json:"limit,omitempty"`
+			Offset  *int      `json:"offset,omitempty"`
+			Reports *[]Report `json:"reports,omitempty"`
+			Total   *int      `json:"total,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// ListReportsParams defines parameters for ListReports.
-type ListReportsParams struct {
-	WorkId       *string `form:"work_id,omitempty" json:"work_id,omitempty"`
-	FileId       *string `form:"file_id,omitempty" json:"file_id,omitempty"`
-	AssignmentId *string `form:"assignment_id,omitempty" json:"assignment_id,omitempty"`
-	StudentId    *string `form:"student_id,omitempty" json:"student_id,omitempty"`
-	Limit        *int    `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset       *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	}
+
+	return response, nil
 }
 
-// AnalyzeFileJSONRequestBody defines body for AnalyzeFile for application/json ContentType.
-type AnalyzeFileJSONRequestBody = AnalysisRequest
+// ParseGetWorkReportsResponse parses an HTTP response from a GetWorkReportsWithResponse call
+func ParseGetWorkReportsResponse(rsp *http.Response) (*GetWorkReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWorkReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Report
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteReportResponse parses an HTTP response from a DeleteReportWithResponse call
+func ParseDeleteReportResponse(rsp *http.Response) (*DeleteReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReportResponse parses an HTTP response from a GetReportWithResponse call
+func ParseGetReportResponse(rsp *http.Response) (*GetReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Report
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReanalyzeReportResponse parses an HTTP response from a ReanalyzeReportWithResponse call
+func ParseReanalyzeReportResponse(rsp *http.Response) (*ReanalyzeReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReanalyzeReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Report
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReportDiffResponse parses an HTTP response from a GetReportDiffWithResponse call
+func ParseGetReportDiffResponse(rsp *http.Response) (*GetReportDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReportDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Analyze a file for plagiarism
 	// (POST /analyze)
 	AnalyzeFile(ctx echo.Context) error
+	// Re-analyze every file of an assignment against the current corpus
+	// (POST /assignments/{assignment_id}/reanalyze)
+	ReanalyzeAssignment(ctx echo.Context, assignmentId string) error
+	// List assignment policies
+	// (GET /policies)
+	ListPolicies(ctx echo.Context) error
+	// Create a policy for an assignment
+	// (POST /policies)
+	CreatePolicy(ctx echo.Context) error
+	// Delete the policy of an assignment
+	// (DELETE /policies/{assignment_id})
+	DeletePolicy(ctx echo.Context, assignmentId string) error
+	// Get the policy of an assignment
+	// (GET /policies/{assignment_id})
+	GetPolicy(ctx echo.Context, assignmentId string) error
+	// Update the policy of an assignment
+	// (PUT /policies/{assignment_id})
+	UpdatePolicy(ctx echo.Context, assignmentId string) error
 	// List reports with filtering
 	// (GET /reports)
 	ListReports(ctx echo.Context, params ListReportsParams) error
 	// Get all reports for a work
 	// (GET /reports/work/{work_id})
 	GetWorkReports(ctx echo.Context, workId string) error
+	// Delete an analysis report
+	// (DELETE /reports/{report_id})
+	DeleteReport(ctx echo.Context, reportId string) error
 	// Get analysis report
 	// (GET /reports/{report_id})
 	GetReport(ctx echo.Context, reportId string) error
+	// Re-analyze the file of a report against the current corpus
+	// (POST /reports/{report_id}/reanalyze)
+	ReanalyzeReport(ctx echo.Context, reportId string) error
+	// Side-by-side comparison of the analyzed file and a similar file
+	// (GET /reports/{report_id}/similar/{similar_id}/diff)
+	GetReportDiff(ctx echo.Context, reportId string, similarId string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -145,6 +2192,88 @@ func (w *ServerInterfaceWrapper) AnalyzeFile(ctx echo.Context) error {
 	return err
 }
 
+// ReanalyzeAssignment converts echo context to params.
+func (w *ServerInterfaceWrapper) ReanalyzeAssignment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReanalyzeAssignment(ctx, assignmentId)
+	return err
+}
+
+// ListPolicies converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPolicies(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPolicies(ctx)
+	return err
+}
+
+// CreatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePolicy(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePolicy(ctx)
+	return err
+}
+
+// DeletePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePolicy(ctx, assignmentId)
+	return err
+}
+
+// GetPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPolicy(ctx, assignmentId)
+	return err
+}
+
+// UpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePolicy(ctx, assignmentId)
+	return err
+}
+
 // ListReports converts echo context to params.
 func (w *ServerInterfaceWrapper) ListReports(ctx echo.Context) error {
 	var err error
@@ -214,6 +2343,22 @@ func (w *ServerInterfaceWrapper) GetWorkReports(ctx echo.Context) error {
 	return err
 }
 
+// DeleteReport converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "report_id" -------------
+	var reportId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "report_id", ctx.Param("report_id"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter report_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteReport(ctx, reportId)
+	return err
+}
+
 // GetReport converts echo context to params.
 func (w *ServerInterfaceWrapper) GetReport(ctx echo.Context) error {
 	var err error
@@ -230,6 +2375,46 @@ func (w *ServerInterfaceWrapper) GetReport(ctx echo.Context) error {
 	return err
 }
 
+// ReanalyzeReport converts echo context to params.
+func (w *ServerInterfaceWrapper) ReanalyzeReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "report_id" -------------
+	var reportId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "report_id", ctx.Param("report_id"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter report_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReanalyzeReport(ctx, reportId)
+	return err
+}
+
+// GetReportDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "report_id" -------------
+	var reportId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "report_id", ctx.Param("report_id"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter report_id: %s", err))
+	}
+
+	// ------------- Path parameter "similar_id" -------------
+	var similarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "similar_id", ctx.Param("similar_id"), &similarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter similar_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportDiff(ctx, reportId, similarId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -259,37 +2444,76 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/analyze", wrapper.AnalyzeFile)
+	router.POST(baseURL+"/assignments/:assignment_id/reanalyze", wrapper.ReanalyzeAssignment)
+	router.GET(baseURL+"/policies", wrapper.ListPolicies)
+	router.POST(baseURL+"/policies", wrapper.CreatePolicy)
+	router.DELETE(baseURL+"/policies/:assignment_id", wrapper.DeletePolicy)
+	router.GET(baseURL+"/policies/:assignment_id", wrapper.GetPolicy)
+	router.PUT(baseURL+"/policies/:assignment_id", wrapper.UpdatePolicy)
 	router.GET(baseURL+"/reports", wrapper.ListReports)
 	router.GET(baseURL+"/reports/work/:work_id", wrapper.GetWorkReports)
+	router.DELETE(baseURL+"/reports/:report_id", wrapper.DeleteReport)
 	router.GET(baseURL+"/reports/:report_id", wrapper.GetReport)
+	router.POST(baseURL+"/reports/:report_id/reanalyze", wrapper.ReanalyzeReport)
+	router.GET(baseURL+"/reports/:report_id/similar/:similar_id/diff", wrapper.GetReportDiff)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xY227cNhN+FYL/f5ECiiXnAAR75xRNGrRoA6dALwpjQUujFWOKVMiRk62x714MqeOK",
-	"u17Yhu9WGs3MN/PNyb7juakbo0Gj46s7bsE1RjvwD+9FcQnfWnBIT7nRCNr/FE2jZC5QGp1+dUbTO5dX",
-	"UAv69X8LJV/x/6Wj6TRIXXrRyF+sNZbvdruEF+ByKxuyw1fkjtnO3y7hnzSC1UJ9AXsLNmg9B4zeL3Pe",
-	"MYPwYcL/MPjBtLp4FhSX4Exrc2DaICu9W/qoUyXLF1qorZNuQlFjTQMWZaBPOCc3ugaNa+lBzz1cDGIm",
-	"C9AoSwmWJxy3DfAVd2il3lDcpVQQtfBBKpjoMjRMEKh/IWbGYVscwvIlyO4B8t3Ym6j638beHNXdJZwq",
-	"S1oo+OqfwdAY29WgYq6/Qu4LcKBnkdkCUEgVklwUklAI9XnyCdoWIhahNwe6rQnIrVCy8KWzDrKEa4Pr",
-	"wHfCZVeKg1B0nK9LIRVMYY9ZqsE5sQFys8zCAtIlNMbGiqf3VLQ2AKz9+1pqWRP4bDBGKDfgW2RRcgt0",
-	"uQWBUKyF91kaW9MvXgiElyjraOX46NeTuObse5JYJ2ayZA4Fto5Jx7o0HS/qhUy6daPERgorXb3095dt",
-	"vZvxG1YAQo5QsBfXwkHBjGZYWXCVUcVPo/trYxQITT5G5bXLjY2E9RlsDhopJnPAWfbyPMvI/pDHUhmB",
-	"POG1+BFoOs+yJEaabuvrwJn1FXAoF07WUgm7ppZxS5C/S4cEr/uM+c/YUL8IoWqOTcEvQZV6mI8VKqwV",
-	"2zA2iMzguRStoijJigL0zPatNH13pDvmUyg2Yop1btow2o8X+2QcndBo0zAX3dalT+J23Qy0zxrkAcTe",
-	"H+rJ8OmV1KWJTG6wtzIHVhrbDX+pN4y6y/l3k7oVumAb0EDzRG9YqDtH3SFRQb9P+rXGessXnz/xhN+C",
-	"dcHj+Vl2llEApgEtGslX/PVZdvaaJ7wRWPl8pv0eokybsBz3tnxdQyEFgtr2S8sx4YHv437htjqvrNGm",
-	"dYxY8/OQ2m54+FTQQg1mKAoe9g04fG+K7dMdDHsrfzdfbLR1/IvJDfcqy57MfbcrItfKQNrQhcy1eQ7O",
-	"la1Svo/fBCAx+wPgdHJx7hL+9hSV2JnoL6W2roXdjrzE2aXyExtHE6QPgl+RflqBUFgRgA34xM3Z/tWL",
-	"f64gv+GPzPneLBgGHvwQlE2+4n/+Fr1pIl0a707pWAhnu5ebEAXLfRg+7L4tD8VNE/9yaN1GWFEDgqUM",
-	"3nFJTr+1YLd0yYia0I3H1hjxIpS4ar+kH6A6v0QeYGAyPR+grWQtcaY4bK+32XyKT8f4+XLPHPJgytLB",
-	"ARfxzTCavHrSgg2xjrmZrMgOZFQ2KbST7oR++ixPBDQoVMzJKS3SXzA9nHl/eGknYt8lVjRDEDz54+To",
-	"++Fq2kEpFX5615X/7mBDfQSko+B4T9Fmi7XUfPgfK9THcv4oju5Nu5/KWIE/IMO6eHP/7B/+Ip+T9hGQ",
-	"CaVmtkWwfB9nd8M1fJSwLs5TuBrP6+dk62FrPEhY/+ftU9DQHwa2z1gk/6Tlt3dIYuSfDPtHIU94axVf",
-	"8QqxWaWpMrlQlXG4epe9e5WKRqa355yy2LmLWh3ADfy6kbfhGqABHE1TLbTYAK2YUauPane1+y8AAP//",
-	"W5ahNlsTAAA=",
+	"H4sIAAAAAAAC/8xbbY/bNhL+KwTvgCaA1nbSFOg5n5K2SQO0l1ySwwF3DgxaGknsUqRKUrvrLPzfD8MX",
+	"SbbpXe9r+m0tkZwh55lnXqi9pLlqWiVBWkPnl1SDaZU04H68ZsVH+LMDY/FXrqQF6f5kbSt4zixXcvqH",
+	"URKfmbyGhuFff9dQ0jn923RYeurfmumrlv+itdJ0s9lktACTa97iOnSO4ogO8jYZ/UnJUvD8cWR/BKM6",
+	"nQNhQgMr1gQuuLEG9XgnLWjJxCfQZ6D9Co+hUpRLjBNMwA/M6D+VfaM6WTzuwUhlSenE4qAwFVd+JZlY",
+	"G25GUGm1akFb7mHEjOGVbEDaJXdKb0t41b8mvABpeclB04zadQt0To3VXFa475ILSK7whgsYzSVWEYZK",
+	"fYUrljEJTYQg+NYQVRJGmk5YfoIPiOlWDTeGKzkh7yUQDa3SluTqDLQhTAicYWto5gsJZ6DXbh3CDcHj",
+	"ZhoKYqBlmlkQa8JkQVrBKs40N83S5Eq7sbYGrhfyXOni5Bx4VVsoCDsDzSqYkKA3DmRFAQXhJXFKyWqy",
+	"kDSj3ELjdrW35fCAac3W+NvYrjhkjk/+3TW2OFf6NDn9P0qfXjl3k1F0cq6hoPP/9QsN5v3ST1GrPyB3",
+	"XNAjdA9cBVjGhfuTFQVHLZj4MBpidQeJFSEuB7JrUJEzJnjhvGfp32VUKrv0kM8oD97Yv2QB9suScQFj",
+	"tYdTasAYVkHCJpuESr8zm9dQvNGsaoJPb+9VaV5xycR1/vwZLuynlklnat5wwfTxU1KafVCC5+uEZ4tK",
+	"aW7rZh8HpuayEkBOogc4LwlPTXAXgtY32UKecynVOZfVaLySYk2GFyWXFehWc2kNeVIyY0FnRIAxpNWQ",
+	"cwNPnRdEewZRNKP9Gkkb3YadXhLLTkGSUqvG7aNltiZKkq4tmE2yTtjVkgmx1HDGDVcyQUE/hc2fc1sT",
+	"zyRxNJ6ZsjVoEtzXfOfPb0Jer0kBJeuEXUh3bI5JSN5pjYr38gguHRnJk0ZQdKWUAI+YXAOzUCyZQ2Cp",
+	"dIN/UdzYieUN7g5D5Hsp1jveNeyWV1JpWFpoWsEsLHNVwP5mf7nIRVcAaRzwjd80HudgExKX8MScVLjh",
+	"cumWWAqQla33Bf3OJW+6hvj3gd1xBuGSWHUK0pAnSLuGKE1Q2fD0Kc1owy5wMp3/MHOy/I9nvSZcWqhA",
+	"R02Cw3G7Xragc5A2MEBapWE4KZUmzJkUI9gKiOAGIwCXcdQSX+Ih9FYphWJ2rOSz2VjLWa+l7JqVV3IU",
+	"eWytwdRKpMKAC0pspc6AnNc8r6Nq3JCG6VOMTGYUxe6olHecu4AuxVsfXZhO8Fbk7qLTnvJ94EyoOLJu",
+	"P6tJovlXde7A6yL/OTMeQvwrFHOyoBYu7II6G7daGUCgLShCbb7oZrPvc8Fk1bEK3C9Y0IXEsSH5cpB8",
+	"kqsGfcIQ3HPbQpGNOMm4tEJwC5oJQyQeoUDpTycLuaBM5zU/g6DCf999mH5+9XGU1/jpCzokPWHoeIgq",
+	"F9IgK7GQKM3JUdnOQh5Md0gy2/FMfsGaVqAR3DG1a1srSY+h8L0RR3Da3hwX6JejEL5DXviahNeYixnL",
+	"bGdwYyEjuDqFvUFe+sblpC7ZhIKsHL3HFJQPgZTUzJBgnqxPF0uujb1RdsjNEhnX2H1NPusOHCpQZqMM",
+	"xhakOBJ9g2BCHIJVdIYkaXOzHJFHWhAvRwRDCrCQI0aerJiBAsNtz19PkzJ2Qbcv5kPP0KhwUtjs5Nls",
+	"9vSO9OaNdcjyGgYnW6oyQcZgyXkNcuAXLALIeN6c8CIeumENxCThO0OAacFBL6Sfaft4uwJjJ+T9uYxD",
+	"QvXD9MHYg6marQk3SwOi9GHXMQfTvkTMVSfDxN3jXySdd2v5/a3/xo3FfYVhPuMhfVYeQX1VbvvJT8XK",
+	"JF0Mod96yS6HcoSDzGOdE2/r04IsfJ76ZwcdFD5qB/RnyO05uJqMnPSP3WFoVWkwmOz2i4+HoC2F9WfP",
+	"zhgXbCUgC1RCTogBIFuMtJXsBq1oRgcFaLa1jSvKlO1ycO91cOlEbnyF078kGk763Xn+RcxKOI/D6XXp",
+	"FIaGpQPU9cF5VJAekRiMIbGXHZShAEugMZRoREPlAiL3Lhm6DYX3TXSH8CKiNtDgUXDdLQPTDD24X4I8",
+	"dySHYHuAF0YRPsmisepcYpGToFDmM2oXEVAfX6yhVGl4AdsHFNKQq4jgSDFQ7EkZc8R35ghZ+0n6XUj+",
+	"Gje6GUL7gnwPniCLpeAStnwi6T+HyqEIrVgPcUlWa+uqq6udTJWlgURa8HptgfiXPQdEGcYyba9d2Y06",
+	"alv7h4WPuCxVKm7qM57DwNFfIziNezYK+Oi0FUjQzOIYH7BdvcmtgJCEkZ7x4sqvPryjI4akzyazycwd",
+	"VQuStZzO6feT2eR7ZGZma2fAafAG/LtVqTTrp54uY5bn6SQGHwxZnmdcEDJDXjCORpOFfEVWLD+tNIZL",
+	"5xagSaPOwoywuFXjsIWrWpdrqHGoUjrEopekVUKQt798JtNwStPLPr/Z9NmhD2i+yYDwdXXWuyKGja/w",
+	"xnNi6Pi/VsX6/hraOy3pzXbXEevHTbZ91/F89vzexIeyM9FN7/HjkwcEyovZ7NB6vYLT0U3MJqM/HDMl",
+	"dW2BCpmuaZheD3YgbIDOVj1vWWUwuYhK0y84fzpUW2Z6uVV6baYarsX2vzxifSYQEBjzB1QBWF57hWzN",
+	"rKtpVgCyDyE+A42BtxefAtrHqM3QxHN+qFkDFjRu7pJyl9VhzMmoZI2Ls+NN0V3kZCMU7BL5lzuiapvp",
+	"+9Rnny8jP80vj0sqIiR3c4k0m+5eAZ2wFG5fXA/C/qrq/lAbtfkK494DtvTkuG/IKsalsQ4lsQ+aK912",
+	"5jCya2DCxUv80WLPOxii8kFvG11YmXyIg/bsPnsYu4+1OsrwoXV/K8PH2qsXen9WdEuPzNUOBxmN05/t",
+	"l012gEveN9xalwiCKIxryjuDh0KOnDGBXNOZWKf1An2zWXV2IZkXvk4xiA/E4QwfJlZFAx0Top49iNSd",
+	"RNu9CRXbbSPUi9k/rp/SX/DfH6y8vUg0aUiHRnZP42vs77sxzaMOk6B0oTWMJiUTwriMC3OqMRADwvbw",
+	"9bNbeISvLXO/SJRBfl9eoW/Kw151n5Z6pVR5zFlnaTZ9C/bQMcweD/XxNvnm57p1Nm/B3uZgHjgzabvr",
+	"KfQUoN25PfQkuo/df7srm78MNz4iSsJl1a258ds4rLfXzXGJ5DhKNw/mQh/7kjmF4z870OsByMNnH4ch",
+	"m6WnxjuUW0zddZ8bLzBq8dxituANt1sT+47zD7PtVtM1V82HJIQGTVLE7OoeTKJ2uUsO6/eazGGHLtLD",
+	"1TUZtcr6L2WOayClM9+oTiJ5Da/8RwslFxa0b7pHF4r+sOVBUwT+9DLAf3PQod6Cxeb01T61HRsGl7pL",
+	"vXozm9+t9rzu2Pte0nm4urlrTMZPkMZr+08arrXZqL11VTboMyJsbSTaZkHuhLwZf8fEZQEXu3e6C4kt",
+	"uphC+qtYoqFhXGKDbljcD9+69XO9zQzFMrxw04BLFcOHSk5guDT2t/BSSUiVPn43wXbH4K8/pDsiMJH1",
+	"ei3ulPWGiuTBv5f9PLqaN8RYLkS8KVSarMC3WH0r6/6zcSbHN4rBdPvgPpyDfwN7zx6h79oD6N7S+2OO",
+	"+QCH3LhL2sva9fQJ+dx32j3clPYfZrmJC+mFxv7qy/7KLzzw182n0FpHhzXHBdZXtlK/AUCePwpA/qI9",
+	"zv5mx320GOx5m+ZmCojhGnR6Gf5wDwtelqO8ZPecZOG+NSN4AX2CVmFcQkF+/fz7b6TFD2mGrzi3bsUl",
+	"XNgQ0xZyfDs9IfFWvb94d7CseVWL+FmY+7RN4leHVvkrAvcpbAqqPYv9jBt5OKBmybWGk7xnWsQPCKe1",
+	"bcQ23HcX2kO2M4z/Lo8bJZ2NviW2P/ECTlbrE3drP9JLlQnQoN3Z7lcUCaJFCU6it3Hi30N2b25pRjst",
+	"6JzW1rbz6VSonIlaGTv/cfbj8yndfOkFJdcbODmCzwwgiMLoJtudHCJRwySrIBTdYVbcz/6kD6BPxncb",
+	"Ufaoax7W6Ov2zZfN/wcAUZZnY9w1AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -23,36 +23,110 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for FileChangeStatus.
+const (
+	Added     FileChangeStatus = "added"
+	Modified  FileChangeStatus = "modified"
+	Removed   FileChangeStatus = "removed"
+	Unchanged FileChangeStatus = "unchanged"
+)
+
+// Defines values for IdempotencyKeyStatus.
+const (
+	Completed IdempotencyKeyStatus = "completed"
+	Pending   IdempotencyKeyStatus = "pending"
+)
+
 // ApiError defines model for ApiError.
 type ApiError struct {
 	Details *string `json:"details,omitempty"`
+
+	// Error Error code, e.g. EMPTY_FILE, FILE_TOO_LARGE, INVALID_ARCHIVE,
+	// REVISION_NOT_LATEST, FILE_TYPE_NOT_ALLOWED, CONTENT_TYPE_MISMATCH,
+	// INVALID_UPLOAD_POLICY, UPLOAD_POLICY_NOT_FOUND,
+	// INVALID_IDEMPOTENCY_KEY, IDEMPOTENCY_KEY_NOT_FOUND,
+	// IDEMPOTENCY_KEY_IN_PROGRESS, IDEMPOTENCY_KEY_MISMATCH
 	Error   *string `json:"error,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// CreateUploadRequest defines model for CreateUploadRequest.
+type CreateUploadRequest struct {
+	AssignmentId string  `json:"assignment_id"`
+	ContentType  *string `json:"content_type,omitempty"`
+	Filename     string  `json:"filename"`
+
+	// SizeBytes Total size of the file
+	SizeBytes int64  `json:"size_bytes"`
+	StudentId string `json:"student_id"`
+}
+
+// FileChange defines model for FileChange.
+type FileChange struct {
+	// Diff Unified diff of a modified text file
+	Diff *string `json:"diff,omitempty"`
+
+	// DiffSkipped Why no diff was built (binary file, too large, too many changes)
+	DiffSkipped  *string `json:"diff_skipped,omitempty"`
+	FromFileId   *string `json:"from_file_id,omitempty"`
+	LinesAdded   *int    `json:"lines_added,omitempty"`
+	LinesRemoved *int    `json:"lines_removed,omitempty"`
+
+	// Path Path inside the archive or file name
+	Path     string           `json:"path"`
+	Status   FileChangeStatus `json:"status"`
+	ToFileId *string          `json:"to_file_id,omitempty"`
+}
+
+// FileChangeStatus defines model for FileChange.Status.
+type FileChangeStatus string
+
 // FileMetadata defines model for FileMetadata.
 type FileMetadata struct {
 	AssignmentId *string `json:"assignment_id,omitempty"`
 
 	// Checksum MD5 or SHA256 checksum
-	Checksum    *string    `json:"checksum,omitempty"`
-	ContentType *string    `json:"content_type,omitempty"`
-	FileId      *string    `json:"file_id,omitempty"`
-	Filename    *string    `json:"filename,omitempty"`
-	SizeBytes   *int64     `json:"size_bytes,omitempty"`
-	StudentId   *string    `json:"student_id,omitempty"`
-	UploadedAt  *time.Time `json:"uploaded_at,omitempty"`
-	WorkId      *string    `json:"work_id,omitempty"`
+	Checksum    *string `json:"checksum,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+	FileId      *string `json:"file_id,omitempty"`
+	Filename    *string `json:"filename,omitempty"`
+
+	// IsArchive True for uploaded archives whose files are stored as members
+	IsArchive *bool `json:"is_archive,omitempty"`
+
+	// IsCurrentRevision True if the file belongs to the current revision of its work
+	IsCurrentRevision *bool `json:"is_current_revision,omitempty"`
+
+	// IsTemplate True for assignment template files
+	IsTemplate *bool `json:"is_template,omitempty"`
+
+	// MemberPath Path of the file inside the archive
+	MemberPath *string `json:"member_path,omitempty"`
+
+	// ParentFileId Archive the file was unpacked from
+	ParentFileId *string `json:"parent_file_id,omitempty"`
+
+	// Revision Revision of the work the file belongs to
+	Revision   *int       `json:"revision,omitempty"`
+	SizeBytes  *int64     `json:"size_bytes,omitempty"`
+	StudentId  *string    `json:"student_id,omitempty"`
+	UploadedAt *time.Time `json:"uploaded_at,omitempty"`
+	WorkId     *string    `json:"work_id,omitempty"`
 }
 
 // FileUploadResponse defines model for FileUploadResponse.
 type FileUploadResponse struct {
 	// FileId Unique file identifier
-	FileId    string  `json:"file_id"`
-	Filename  *string `json:"filename,omitempty"`
-	SizeBytes *int    `json:"size_bytes,omitempty"`
+	FileId   string  `json:"file_id"`
+	Filename *string `json:"filename,omitempty"`
 
-	// StoragePath Path where file is stored (local or S3)
+	// Revision Revision of the work the file belongs to
+	Revision  *int `json:"revision,omitempty"`
+	SizeBytes *int `json:"size_bytes,omitempty"`
+
+	// StoragePath Backend-qualified key of the stored content. Content is stored once
+	// per SHA-256 and shared by all files with the same checksum,
+	// e.g. local://ab/ab12... or s3://bucket/ab/ab12...
 	StoragePath *string    `json:"storage_path,omitempty"`
 	UploadedAt  *time.Time `json:"uploaded_at,omitempty"`
 
@@ -60,6 +134,119 @@ type FileUploadResponse struct {
 	WorkId string `json:"work_id"`
 }
 
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	FileId    *string   `json:"file_id,omitempty"`
+	KeyId     string    `json:"key_id"`
+
+	// LockedUntil Until when a pending key is held by its request
+	LockedUntil    *time.Time           `json:"locked_until,omitempty"`
+	ReportId       *string              `json:"report_id,omitempty"`
+	RequestHash    string               `json:"request_hash"`
+	ResponseBody   *string              `json:"response_body,omitempty"`
+	ResponseStatus *int                 `json:"response_status,omitempty"`
+	Status         IdempotencyKeyStatus `json:"status"`
+	WorkId         *string              `json:"work_id,omitempty"`
+}
+
+// IdempotencyKeyStatus defines model for IdempotencyKey.Status.
+type IdempotencyKeyStatus string
+
+// IdempotencyKeyRequest defines model for IdempotencyKeyRequest.
+type IdempotencyKeyRequest struct {
+	// KeyId Client key, unique within its owner
+	KeyId string `json:"key_id"`
+
+	// RequestHash Hex SHA-256 fingerprint of the request
+	RequestHash string `json:"request_hash"`
+}
+
+// IdempotencyKeyResponse defines model for IdempotencyKeyResponse.
+type IdempotencyKeyResponse struct {
+	FileId      *string `json:"file_id,omitempty"`
+	ReportId    *string `json:"report_id,omitempty"`
+	RequestHash string  `json:"request_hash"`
+
+	// ResponseBody Response body (JSON) returned to replays as is
+	ResponseBody string `json:"response_body"`
+
+	// ResponseStatus HTTP status of the response
+	ResponseStatus int     `json:"response_status"`
+	WorkId         *string `json:"work_id,omitempty"`
+}
+
+// RevisionDiff defines model for RevisionDiff.
+type RevisionDiff struct {
+	Changes      []FileChange `json:"changes"`
+	FromRevision int          `json:"from_revision"`
+	ToRevision   int          `json:"to_revision"`
+	WorkId       string       `json:"work_id"`
+}
+
+// UploadPolicy defines model for UploadPolicy.
+type UploadPolicy struct {
+	AllowedTypes []string  `json:"allowed_types"`
+	AssignmentId string    `json:"assignment_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	MaxFileSize  *int64    `json:"max_file_size,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// UploadPolicyRequest defines model for UploadPolicyRequest.
+type UploadPolicyRequest struct {
+	// AllowedTypes Allowed content types, type/subtype or type/* (e.g. application/pdf,
+	// text/*). Empty or missing allows any type.
+	AllowedTypes *[]string `json:"allowed_types,omitempty"`
+
+	// MaxFileSize Maximum file size in bytes; the service-wide limit if missing
+	MaxFileSize *int64 `json:"max_file_size,omitempty"`
+}
+
+// UploadSession defines model for UploadSession.
+type UploadSession struct {
+	AssignmentId *string   `json:"assignment_id,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Filename     *string   `json:"filename,omitempty"`
+
+	// Offset Bytes received so far
+	Offset    int64   `json:"offset"`
+	SizeBytes int64   `json:"size_bytes"`
+	StudentId *string `json:"student_id,omitempty"`
+	UploadId  string  `json:"upload_id"`
+}
+
+// Work defines model for Work.
+type Work struct {
+	AssignmentId    string    `json:"assignment_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	CurrentRevision int       `json:"current_revision"`
+	IsTemplate      bool      `json:"is_template"`
+	LatestRevision  int       `json:"latest_revision"`
+	StudentId       string    `json:"student_id"`
+	WorkId          string    `json:"work_id"`
+}
+
+// WorkRevision defines model for WorkRevision.
+type WorkRevision struct {
+	// Files Uploaded files; archive contents are listed by GET /files/{file_id}/members
+	Files      []FileMetadata `json:"files"`
+	IsCurrent  bool           `json:"is_current"`
+	Revision   int            `json:"revision"`
+	UploadedAt time.Time      `json:"uploaded_at"`
+	WorkId     string         `json:"work_id"`
+}
+
+// IdempotencyKeyId defines model for IdempotencyKeyId.
+type IdempotencyKeyId = string
+
+// Revision defines model for Revision.
+type Revision = int
+
+// WorkId defines model for WorkId.
+type WorkId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ApiError
 
@@ -69,16 +256,61 @@ type InternalServerError = ApiError
 // NotFound defines model for NotFound.
 type NotFound = ApiError
 
+// UnsupportedMediaType defines model for UnsupportedMediaType.
+type UnsupportedMediaType = ApiError
+
+// UploadTemplateMultipartBody defines parameters for UploadTemplate.
+type UploadTemplateMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
 // UploadFileMultipartBody defines parameters for UploadFile.
 type UploadFileMultipartBody struct {
 	AssignmentId string             `json:"assignment_id"`
 	File         openapi_types.File `json:"file"`
-	StudentId    string             `json:"student_id"`
+
+	// Revision Latest revision of the work to add the file to
+	Revision  *int   `json:"revision,omitempty"`
+	StudentId string `json:"student_id"`
+}
+
+// UploadChunkParams defines parameters for UploadChunk.
+type UploadChunkParams struct {
+	UploadOffset int64 `json:"Upload-Offset"`
+}
+
+// SetCurrentRevisionJSONBody defines parameters for SetCurrentRevision.
+type SetCurrentRevisionJSONBody struct {
+	Revision int `json:"revision"`
+}
+
+// DiffWorkRevisionsParams defines parameters for DiffWorkRevisions.
+type DiffWorkRevisionsParams struct {
+	From int `form:"from" json:"from"`
+	To   int `form:"to" json:"to"`
 }
 
+// UploadTemplateMultipartRequestBody defines body for UploadTemplate for multipart/form-data ContentType.
+type UploadTemplateMultipartRequestBody UploadTemplateMultipartBody
+
+// SetUploadPolicyJSONRequestBody defines body for SetUploadPolicy for application/json ContentType.
+type SetUploadPolicyJSONRequestBody = UploadPolicyRequest
+
 // UploadFileMultipartRequestBody defines body for UploadFile for multipart/form-data ContentType.
 type UploadFileMultipartRequestBody UploadFileMultipartBody
 
+// ReserveIdempotencyKeyJSONRequestBody defines body for ReserveIdempotencyKey for application/json ContentType.
+type ReserveIdempotencyKeyJSONRequestBody = IdempotencyKeyRequest
+
+// CompleteIdempotencyKeyJSONRequestBody defines body for CompleteIdempotencyKey for application/json ContentType.
+type CompleteIdempotencyKeyJSONRequestBody = IdempotencyKeyResponse
+
+// CreateUploadJSONRequestBody defines body for CreateUpload for application/json ContentType.
+type CreateUploadJSONRequestBody = CreateUploadRequest
+
+// SetCurrentRevisionJSONRequestBody defines body for SetCurrentRevision for application/json ContentType.
+type SetCurrentRevisionJSONRequestBody SetCurrentRevisionJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -152,6 +384,26 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListTemplates request
+	ListTemplates(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadTemplateWithBody request with any body
+	UploadTemplateWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTemplate request
+	DeleteTemplate(ctx context.Context, assignmentId string, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUploadPolicy request
+	DeleteUploadPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUploadPolicy request
+	GetUploadPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUploadPolicyWithBody request with any body
+	SetUploadPolicyWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUploadPolicy(ctx context.Context, assignmentId string, body SetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UploadFileWithBody request with any body
 	UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -161,15 +413,74 @@ type ClientInterface interface {
 	// CheckFileExists request
 	CheckFileExists(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListArchiveMembers request
+	ListArchiveMembers(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFileMetadata request
 	GetFileMetadata(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReserveIdempotencyKeyWithBody request with any body
+	ReserveIdempotencyKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReserveIdempotencyKey(ctx context.Context, body ReserveIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReleaseIdempotencyKey request
+	ReleaseIdempotencyKey(ctx context.Context, keyId IdempotencyKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteIdempotencyKeyWithBody request with any body
+	CompleteIdempotencyKeyWithBody(ctx context.Context, keyId IdempotencyKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompleteIdempotencyKey(ctx context.Context, keyId IdempotencyKeyId, body CompleteIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFileContentInternal request
 	GetFileContentInternal(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUploadWithBody request with any body
+	CreateUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUpload(ctx context.Context, body CreateUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelUpload request
+	CancelUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUpload request
+	GetUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUploadOffset request
+	GetUploadOffset(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadChunkWithBody request with any body
+	UploadChunkWithBody(ctx context.Context, uploadId string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteUpload request
+	CompleteUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWork request
+	GetWork(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetCurrentRevisionWithBody request with any body
+	SetCurrentRevisionWithBody(ctx context.Context, workId WorkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetCurrentRevision(ctx context.Context, workId WorkId, body SetCurrentRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffWorkRevisions request
+	DiffWorkRevisions(ctx context.Context, workId WorkId, params *DiffWorkRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWorkRevisions request
+	ListWorkRevisions(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWorkRevision request
+	DeleteWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkRevision request
+	GetWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadWorkRevision request
+	DownloadWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadFileRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListTemplates(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTemplatesRequest(c.Server, assignmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +491,8 @@ func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFile(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileRequest(c.Server, fileId)
+func (c *Client) UploadTemplateWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadTemplateRequestWithBody(c.Server, assignmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -192,8 +503,8 @@ func (c *Client) GetFile(ctx context.Context, fileId string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) CheckFileExists(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCheckFileExistsRequest(c.Server, fileId)
+func (c *Client) DeleteTemplate(ctx context.Context, assignmentId string, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTemplateRequest(c.Server, assignmentId, fileId)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +515,8 @@ func (c *Client) CheckFileExists(ctx context.Context, fileId string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFileMetadata(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileMetadataRequest(c.Server, fileId)
+func (c *Client) DeleteUploadPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUploadPolicyRequest(c.Server, assignmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -216,8 +527,8 @@ func (c *Client) GetFileMetadata(ctx context.Context, fileId string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFileContentInternal(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileContentInternalRequest(c.Server, fileId)
+func (c *Client) GetUploadPolicy(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadPolicyRequest(c.Server, assignmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -228,413 +539,3410 @@ func (c *Client) GetFileContentInternal(ctx context.Context, fileId string, reqE
 	return c.Client.Do(req)
 }
 
-// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
-func NewUploadFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetUploadPolicyWithBody(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUploadPolicyRequestWithBody(c.Server, assignmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/files")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetUploadPolicy(ctx context.Context, assignmentId string, body SetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUploadPolicyRequest(c.Server, assignmentId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFileRequest generates requests for GetFile
-func NewGetFileRequest(server string, fileId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadFileRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetFile(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileRequest(c.Server, fileId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/files/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CheckFileExists(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckFileExistsRequest(c.Server, fileId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCheckFileExistsRequest generates requests for CheckFileExists
-func NewCheckFileExistsRequest(server string, fileId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+func (c *Client) ListArchiveMembers(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListArchiveMembersRequest(c.Server, fileId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetFileMetadata(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileMetadataRequest(c.Server, fileId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/files/%s/exists", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReserveIdempotencyKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReserveIdempotencyKeyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFileMetadataRequest generates requests for GetFileMetadata
-func NewGetFileMetadataRequest(server string, fileId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+func (c *Client) ReserveIdempotencyKey(ctx context.Context, body ReserveIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReserveIdempotencyKeyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ReleaseIdempotencyKey(ctx context.Context, keyId IdempotencyKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseIdempotencyKeyRequest(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/files/%s/metadata", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CompleteIdempotencyKeyWithBody(ctx context.Context, keyId IdempotencyKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteIdempotencyKeyRequestWithBody(c.Server, keyId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFileContentInternalRequest generates requests for GetFileContentInternal
-func NewGetFileContentInternalRequest(server string, fileId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+func (c *Client) CompleteIdempotencyKey(ctx context.Context, keyId IdempotencyKeyId, body CompleteIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteIdempotencyKeyRequest(c.Server, keyId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetFileContentInternal(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileContentInternalRequest(c.Server, fileId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/files/%s/content", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) CreateUpload(ctx context.Context, body CreateUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) CancelUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelUploadRequest(c.Server, uploadId)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadRequest(c.Server, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) GetUploadOffset(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadOffsetRequest(c.Server, uploadId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) UploadChunkWithBody(ctx context.Context, uploadId string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadChunkRequestWithBody(c.Server, uploadId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// UploadFileWithBodyWithResponse request with any body
-	UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+func (c *Client) CompleteUpload(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteUploadRequest(c.Server, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// GetFileWithResponse request
-	GetFileWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileResponse, error)
+func (c *Client) GetWork(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkRequest(c.Server, workId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// CheckFileExistsWithResponse request
-	CheckFileExistsWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*CheckFileExistsResponse, error)
+func (c *Client) SetCurrentRevisionWithBody(ctx context.Context, workId WorkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCurrentRevisionRequestWithBody(c.Server, workId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// GetFileMetadataWithResponse request
-	GetFileMetadataWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileMetadataResponse, error)
+func (c *Client) SetCurrentRevision(ctx context.Context, workId WorkId, body SetCurrentRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCurrentRevisionRequest(c.Server, workId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// GetFileContentInternalWithResponse request
-	GetFileContentInternalWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileContentInternalResponse, error)
+func (c *Client) DiffWorkRevisions(ctx context.Context, workId WorkId, params *DiffWorkRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffWorkRevisionsRequest(c.Server, workId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UploadFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *FileUploadResponse
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+func (c *Client) ListWorkRevisions(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkRevisionsRequest(c.Server, workId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UploadFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) DeleteWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWorkRevisionRequest(c.Server, workId, revision)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkRevisionRequest(c.Server, workId, revision)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
+func (c *Client) DownloadWorkRevision(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadWorkRevisionRequest(c.Server, workId, revision)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListTemplatesRequest generates requests for ListTemplates
+func NewListTemplatesRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/templates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUploadTemplateRequestWithBody generates requests for UploadTemplate with any type of body
+func NewUploadTemplateRequestWithBody(server string, assignmentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/templates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type CheckFileExistsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// Files file ids if file exists, nothing otherwise
-		Files []string `json:"files"`
+// NewDeleteTemplateRequest generates requests for DeleteTemplate
+func NewDeleteTemplateRequest(server string, assignmentId string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/templates/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUploadPolicyRequest generates requests for DeleteUploadPolicy
+func NewDeleteUploadPolicyRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/upload-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUploadPolicyRequest generates requests for GetUploadPolicy
+func NewGetUploadPolicyRequest(server string, assignmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/upload-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetUploadPolicyRequest calls the generic SetUploadPolicy builder with application/json body
+func NewSetUploadPolicyRequest(server string, assignmentId string, body SetUploadPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUploadPolicyRequestWithBody(server, assignmentId, "application/json", bodyReader)
+}
+
+// NewSetUploadPolicyRequestWithBody generates requests for SetUploadPolicy with any type of body
+func NewSetUploadPolicyRequestWithBody(server string, assignmentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assignment_id", runtime.ParamLocationPath, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assignments/%s/upload-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
+func NewUploadFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFileRequest generates requests for GetFile
+func NewGetFileRequest(server string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckFileExistsRequest generates requests for CheckFileExists
+func NewCheckFileExistsRequest(server string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files/%s/exists", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListArchiveMembersRequest generates requests for ListArchiveMembers
+func NewListArchiveMembersRequest(server string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFileMetadataRequest generates requests for GetFileMetadata
+func NewGetFileMetadataRequest(server string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files/%s/metadata", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReserveIdempotencyKeyRequest calls the generic ReserveIdempotencyKey builder with application/json body
+func NewReserveIdempotencyKeyRequest(server string, body ReserveIdempotencyKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReserveIdempotencyKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReserveIdempotencyKeyRequestWithBody generates requests for ReserveIdempotencyKey with any type of body
+func NewReserveIdempotencyKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/idempotency-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReleaseIdempotencyKeyRequest generates requests for ReleaseIdempotencyKey
+func NewReleaseIdempotencyKeyRequest(server string, keyId IdempotencyKeyId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/idempotency-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteIdempotencyKeyRequest calls the generic CompleteIdempotencyKey builder with application/json body
+func NewCompleteIdempotencyKeyRequest(server string, keyId IdempotencyKeyId, body CompleteIdempotencyKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompleteIdempotencyKeyRequestWithBody(server, keyId, "application/json", bodyReader)
+}
+
+// NewCompleteIdempotencyKeyRequestWithBody generates requests for CompleteIdempotencyKey with any type of body
+func NewCompleteIdempotencyKeyRequestWithBody(server string, keyId IdempotencyKeyId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/idempotency-keys/%s/response", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFileContentInternalRequest generates requests for GetFileContentInternal
+func NewGetFileContentInternalRequest(server string, fileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "file_id", runtime.ParamLocationPath, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/files/%s/content", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUploadRequest calls the generic CreateUpload builder with application/json body
+func NewCreateUploadRequest(server string, body CreateUploadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUploadRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUploadRequestWithBody generates requests for CreateUpload with any type of body
+func NewCreateUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelUploadRequest generates requests for CancelUpload
+func NewCancelUploadRequest(server string, uploadId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "upload_id", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUploadRequest generates requests for GetUpload
+func NewGetUploadRequest(server string, uploadId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "upload_id", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUploadOffsetRequest generates requests for GetUploadOffset
+func NewGetUploadOffsetRequest(server string, uploadId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "upload_id", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadChunkRequestWithBody generates requests for UploadChunk with any type of body
+func NewUploadChunkRequestWithBody(server string, uploadId string, params *UploadChunkParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "upload_id", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Upload-Offset", runtime.ParamLocationHeader, params.UploadOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Upload-Offset", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewCompleteUploadRequest generates requests for CompleteUpload
+func NewCompleteUploadRequest(server string, uploadId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "upload_id", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkRequest generates requests for GetWork
+func NewGetWorkRequest(server string, workId WorkId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetCurrentRevisionRequest calls the generic SetCurrentRevision builder with application/json body
+func NewSetCurrentRevisionRequest(server string, workId WorkId, body SetCurrentRevisionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCurrentRevisionRequestWithBody(server, workId, "application/json", bodyReader)
+}
+
+// NewSetCurrentRevisionRequestWithBody generates requests for SetCurrentRevision with any type of body
+func NewSetCurrentRevisionRequestWithBody(server string, workId WorkId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/current-revision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDiffWorkRevisionsRequest generates requests for DiffWorkRevisions
+func NewDiffWorkRevisionsRequest(server string, workId WorkId, params *DiffWorkRevisionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWorkRevisionsRequest generates requests for ListWorkRevisions
+func NewListWorkRevisionsRequest(server string, workId WorkId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/revisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWorkRevisionRequest generates requests for DeleteWorkRevision
+func NewDeleteWorkRevisionRequest(server string, workId WorkId, revision Revision) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision", runtime.ParamLocationPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/revisions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkRevisionRequest generates requests for GetWorkRevision
+func NewGetWorkRevisionRequest(server string, workId WorkId, revision Revision) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision", runtime.ParamLocationPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/revisions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadWorkRevisionRequest generates requests for DownloadWorkRevision
+func NewDownloadWorkRevisionRequest(server string, workId WorkId, revision Revision) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "work_id", runtime.ParamLocationPath, workId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision", runtime.ParamLocationPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/works/%s/revisions/%s/download", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListTemplatesWithResponse request
+	ListTemplatesWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error)
+
+	// UploadTemplateWithBodyWithResponse request with any body
+	UploadTemplateWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTemplateResponse, error)
+
+	// DeleteTemplateWithResponse request
+	DeleteTemplateWithResponse(ctx context.Context, assignmentId string, fileId string, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error)
+
+	// DeleteUploadPolicyWithResponse request
+	DeleteUploadPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*DeleteUploadPolicyResponse, error)
+
+	// GetUploadPolicyWithResponse request
+	GetUploadPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error)
+
+	// SetUploadPolicyWithBodyWithResponse request with any body
+	SetUploadPolicyWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUploadPolicyResponse, error)
+
+	SetUploadPolicyWithResponse(ctx context.Context, assignmentId string, body SetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUploadPolicyResponse, error)
+
+	// UploadFileWithBodyWithResponse request with any body
+	UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+
+	// GetFileWithResponse request
+	GetFileWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileResponse, error)
+
+	// CheckFileExistsWithResponse request
+	CheckFileExistsWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*CheckFileExistsResponse, error)
+
+	// ListArchiveMembersWithResponse request
+	ListArchiveMembersWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*ListArchiveMembersResponse, error)
+
+	// GetFileMetadataWithResponse request
+	GetFileMetadataWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileMetadataResponse, error)
+
+	// ReserveIdempotencyKeyWithBodyWithResponse request with any body
+	ReserveIdempotencyKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveIdempotencyKeyResponse, error)
+
+	ReserveIdempotencyKeyWithResponse(ctx context.Context, body ReserveIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*ReserveIdempotencyKeyResponse, error)
+
+	// ReleaseIdempotencyKeyWithResponse request
+	ReleaseIdempotencyKeyWithResponse(ctx context.Context, keyId IdempotencyKeyId, reqEditors ...RequestEditorFn) (*ReleaseIdempotencyKeyResponse, error)
+
+	// CompleteIdempotencyKeyWithBodyWithResponse request with any body
+	CompleteIdempotencyKeyWithBodyWithResponse(ctx context.Context, keyId IdempotencyKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteIdempotencyKeyResponse, error)
+
+	CompleteIdempotencyKeyWithResponse(ctx context.Context, keyId IdempotencyKeyId, body CompleteIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteIdempotencyKeyResponse, error)
+
+	// GetFileContentInternalWithResponse request
+	GetFileContentInternalWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileContentInternalResponse, error)
+
+	// CreateUploadWithBodyWithResponse request with any body
+	CreateUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error)
+
+	CreateUploadWithResponse(ctx context.Context, body CreateUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error)
+
+	// CancelUploadWithResponse request
+	CancelUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*CancelUploadResponse, error)
+
+	// GetUploadWithResponse request
+	GetUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*GetUploadResponse, error)
+
+	// GetUploadOffsetWithResponse request
+	GetUploadOffsetWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*GetUploadOffsetResponse, error)
+
+	// UploadChunkWithBodyWithResponse request with any body
+	UploadChunkWithBodyWithResponse(ctx context.Context, uploadId string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadChunkResponse, error)
+
+	// CompleteUploadWithResponse request
+	CompleteUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*CompleteUploadResponse, error)
+
+	// GetWorkWithResponse request
+	GetWorkWithResponse(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*GetWorkResponse, error)
+
+	// SetCurrentRevisionWithBodyWithResponse request with any body
+	SetCurrentRevisionWithBodyWithResponse(ctx context.Context, workId WorkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCurrentRevisionResponse, error)
+
+	SetCurrentRevisionWithResponse(ctx context.Context, workId WorkId, body SetCurrentRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCurrentRevisionResponse, error)
+
+	// DiffWorkRevisionsWithResponse request
+	DiffWorkRevisionsWithResponse(ctx context.Context, workId WorkId, params *DiffWorkRevisionsParams, reqEditors ...RequestEditorFn) (*DiffWorkRevisionsResponse, error)
+
+	// ListWorkRevisionsWithResponse request
+	ListWorkRevisionsWithResponse(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*ListWorkRevisionsResponse, error)
+
+	// DeleteWorkRevisionWithResponse request
+	DeleteWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*DeleteWorkRevisionResponse, error)
+
+	// GetWorkRevisionWithResponse request
+	GetWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*GetWorkRevisionResponse, error)
+
+	// DownloadWorkRevisionWithResponse request
+	DownloadWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*DownloadWorkRevisionResponse, error)
+}
+
+type ListTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Files []FileMetadata `json:"files"`
+	}
+	JSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FileUploadResponse
+	JSON400      *BadRequest
+	JSON415      *UnsupportedMediaType
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUploadPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUploadPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUploadPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadPolicy
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUploadPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadPolicy
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUploadPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUploadPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FileUploadResponse
+	JSON400      *BadRequest
+	JSON409      *ApiError
+	JSON415      *UnsupportedMediaType
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckFileExistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Files file ids if file exists, nothing otherwise
+		Files []string `json:"files"`
+	}
+	JSON400 *BadRequest
+	JSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckFileExistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckFileExistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListArchiveMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Files []FileMetadata `json:"files"`
+	}
+	JSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListArchiveMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListArchiveMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileMetadata
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReserveIdempotencyKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdempotencyKey
+	JSON201      *IdempotencyKey
+	JSON400      *BadRequest
+	JSON409      *ApiError
+	JSON422      *ApiError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReserveIdempotencyKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReserveIdempotencyKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReleaseIdempotencyKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReleaseIdempotencyKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReleaseIdempotencyKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteIdempotencyKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdempotencyKey
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteIdempotencyKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteIdempotencyKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileContentInternalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileContentInternalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileContentInternalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadSession
+	JSON400      *BadRequest
+	JSON415      *UnsupportedMediaType
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *ApiError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadSession
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadOffsetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadOffsetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadOffsetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadChunkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *ApiError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadChunkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadChunkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FileUploadResponse
+	JSON404      *NotFound
+	JSON409      *ApiError
+	JSON415      *UnsupportedMediaType
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Work
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCurrentRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkRevision
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCurrentRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCurrentRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffWorkRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevisionDiff
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffWorkRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffWorkRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWorkRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Revisions []WorkRevision `json:"revisions"`
+	}
+	JSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWorkRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *ApiError
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWorkRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWorkRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkRevision
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadWorkRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadWorkRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadWorkRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListTemplatesWithResponse request returning *ListTemplatesResponse
+func (c *ClientWithResponses) ListTemplatesWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error) {
+	rsp, err := c.ListTemplates(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTemplatesResponse(rsp)
+}
+
+// UploadTemplateWithBodyWithResponse request with arbitrary body returning *UploadTemplateResponse
+func (c *ClientWithResponses) UploadTemplateWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTemplateResponse, error) {
+	rsp, err := c.UploadTemplateWithBody(ctx, assignmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadTemplateResponse(rsp)
+}
+
+// DeleteTemplateWithResponse request returning *DeleteTemplateResponse
+func (c *ClientWithResponses) DeleteTemplateWithResponse(ctx context.Context, assignmentId string, fileId string, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error) {
+	rsp, err := c.DeleteTemplate(ctx, assignmentId, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTemplateResponse(rsp)
+}
+
+// DeleteUploadPolicyWithResponse request returning *DeleteUploadPolicyResponse
+func (c *ClientWithResponses) DeleteUploadPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*DeleteUploadPolicyResponse, error) {
+	rsp, err := c.DeleteUploadPolicy(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUploadPolicyResponse(rsp)
+}
+
+// GetUploadPolicyWithResponse request returning *GetUploadPolicyResponse
+func (c *ClientWithResponses) GetUploadPolicyWithResponse(ctx context.Context, assignmentId string, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error) {
+	rsp, err := c.GetUploadPolicy(ctx, assignmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadPolicyResponse(rsp)
+}
+
+// SetUploadPolicyWithBodyWithResponse request with arbitrary body returning *SetUploadPolicyResponse
+func (c *ClientWithResponses) SetUploadPolicyWithBodyWithResponse(ctx context.Context, assignmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUploadPolicyResponse, error) {
+	rsp, err := c.SetUploadPolicyWithBody(ctx, assignmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUploadPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUploadPolicyWithResponse(ctx context.Context, assignmentId string, body SetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUploadPolicyResponse, error) {
+	rsp, err := c.SetUploadPolicy(ctx, assignmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUploadPolicyResponse(rsp)
+}
+
+// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
+func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
+	rsp, err := c.UploadFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadFileResponse(rsp)
+}
+
+// GetFileWithResponse request returning *GetFileResponse
+func (c *ClientWithResponses) GetFileWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileResponse, error) {
+	rsp, err := c.GetFile(ctx, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileResponse(rsp)
+}
+
+// CheckFileExistsWithResponse request returning *CheckFileExistsResponse
+func (c *ClientWithResponses) CheckFileExistsWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*CheckFileExistsResponse, error) {
+	rsp, err := c.CheckFileExists(ctx, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckFileExistsResponse(rsp)
+}
+
+// ListArchiveMembersWithResponse request returning *ListArchiveMembersResponse
+func (c *ClientWithResponses) ListArchiveMembersWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*ListArchiveMembersResponse, error) {
+	rsp, err := c.ListArchiveMembers(ctx, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListArchiveMembersResponse(rsp)
+}
+
+// GetFileMetadataWithResponse request returning *GetFileMetadataResponse
+func (c *ClientWithResponses) GetFileMetadataWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileMetadataResponse, error) {
+	rsp, err := c.GetFileMetadata(ctx, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileMetadataResponse(rsp)
+}
+
+// ReserveIdempotencyKeyWithBodyWithResponse request with arbitrary body returning *ReserveIdempotencyKeyResponse
+func (c *ClientWithResponses) ReserveIdempotencyKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveIdempotencyKeyResponse, error) {
+	rsp, err := c.ReserveIdempotencyKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReserveIdempotencyKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReserveIdempotencyKeyWithResponse(ctx context.Context, body ReserveIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*ReserveIdempotencyKeyResponse, error) {
+	rsp, err := c.ReserveIdempotencyKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReserveIdempotencyKeyResponse(rsp)
+}
+
+// ReleaseIdempotencyKeyWithResponse request returning *ReleaseIdempotencyKeyResponse
+func (c *ClientWithResponses) ReleaseIdempotencyKeyWithResponse(ctx context.Context, keyId IdempotencyKeyId, reqEditors ...RequestEditorFn) (*ReleaseIdempotencyKeyResponse, error) {
+	rsp, err := c.ReleaseIdempotencyKey(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseIdempotencyKeyResponse(rsp)
+}
+
+// CompleteIdempotencyKeyWithBodyWithResponse request with arbitrary body returning *CompleteIdempotencyKeyResponse
+func (c *ClientWithResponses) CompleteIdempotencyKeyWithBodyWithResponse(ctx context.Context, keyId IdempotencyKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteIdempotencyKeyResponse, error) {
+	rsp, err := c.CompleteIdempotencyKeyWithBody(ctx, keyId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteIdempotencyKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompleteIdempotencyKeyWithResponse(ctx context.Context, keyId IdempotencyKeyId, body CompleteIdempotencyKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteIdempotencyKeyResponse, error) {
+	rsp, err := c.CompleteIdempotencyKey(ctx, keyId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteIdempotencyKeyResponse(rsp)
+}
+
+// GetFileContentInternalWithResponse request returning *GetFileContentInternalResponse
+func (c *ClientWithResponses) GetFileContentInternalWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileContentInternalResponse, error) {
+	rsp, err := c.GetFileContentInternal(ctx, fileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileContentInternalResponse(rsp)
+}
+
+// CreateUploadWithBodyWithResponse request with arbitrary body returning *CreateUploadResponse
+func (c *ClientWithResponses) CreateUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error) {
+	rsp, err := c.CreateUploadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUploadWithResponse(ctx context.Context, body CreateUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error) {
+	rsp, err := c.CreateUpload(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadResponse(rsp)
+}
+
+// CancelUploadWithResponse request returning *CancelUploadResponse
+func (c *ClientWithResponses) CancelUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*CancelUploadResponse, error) {
+	rsp, err := c.CancelUpload(ctx, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelUploadResponse(rsp)
+}
+
+// GetUploadWithResponse request returning *GetUploadResponse
+func (c *ClientWithResponses) GetUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*GetUploadResponse, error) {
+	rsp, err := c.GetUpload(ctx, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadResponse(rsp)
+}
+
+// GetUploadOffsetWithResponse request returning *GetUploadOffsetResponse
+func (c *ClientWithResponses) GetUploadOffsetWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*GetUploadOffsetResponse, error) {
+	rsp, err := c.GetUploadOffset(ctx, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadOffsetResponse(rsp)
+}
+
+// UploadChunkWithBodyWithResponse request with arbitrary body returning *UploadChunkResponse
+func (c *ClientWithResponses) UploadChunkWithBodyWithResponse(ctx context.Context, uploadId string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadChunkResponse, error) {
+	rsp, err := c.UploadChunkWithBody(ctx, uploadId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadChunkResponse(rsp)
+}
+
+// CompleteUploadWithResponse request returning *CompleteUploadResponse
+func (c *ClientWithResponses) CompleteUploadWithResponse(ctx context.Context, uploadId string, reqEditors ...RequestEditorFn) (*CompleteUploadResponse, error) {
+	rsp, err := c.CompleteUpload(ctx, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteUploadResponse(rsp)
+}
+
+// GetWorkWithResponse request returning *GetWorkResponse
+func (c *ClientWithResponses) GetWorkWithResponse(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*GetWorkResponse, error) {
+	rsp, err := c.GetWork(ctx, workId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkResponse(rsp)
+}
+
+// SetCurrentRevisionWithBodyWithResponse request with arbitrary body returning *SetCurrentRevisionResponse
+func (c *ClientWithResponses) SetCurrentRevisionWithBodyWithResponse(ctx context.Context, workId WorkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCurrentRevisionResponse, error) {
+	rsp, err := c.SetCurrentRevisionWithBody(ctx, workId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCurrentRevisionResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetCurrentRevisionWithResponse(ctx context.Context, workId WorkId, body SetCurrentRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCurrentRevisionResponse, error) {
+	rsp, err := c.SetCurrentRevision(ctx, workId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCurrentRevisionResponse(rsp)
+}
+
+// DiffWorkRevisionsWithResponse request returning *DiffWorkRevisionsResponse
+func (c *ClientWithResponses) DiffWorkRevisionsWithResponse(ctx context.Context, workId WorkId, params *DiffWorkRevisionsParams, reqEditors ...RequestEditorFn) (*DiffWorkRevisionsResponse, error) {
+	rsp, err := c.DiffWorkRevisions(ctx, workId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffWorkRevisionsResponse(rsp)
+}
+
+// ListWorkRevisionsWithResponse request returning *ListWorkRevisionsResponse
+func (c *ClientWithResponses) ListWorkRevisionsWithResponse(ctx context.Context, workId WorkId, reqEditors ...RequestEditorFn) (*ListWorkRevisionsResponse, error) {
+	rsp, err := c.ListWorkRevisions(ctx, workId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkRevisionsResponse(rsp)
+}
+
+// DeleteWorkRevisionWithResponse request returning *DeleteWorkRevisionResponse
+func (c *ClientWithResponses) DeleteWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*DeleteWorkRevisionResponse, error) {
+	rsp, err := c.DeleteWorkRevision(ctx, workId, revision, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWorkRevisionResponse(rsp)
+}
+
+// GetWorkRevisionWithResponse request returning *GetWorkRevisionResponse
+func (c *ClientWithResponses) GetWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*GetWorkRevisionResponse, error) {
+	rsp, err := c.GetWorkRevision(ctx, workId, revision, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkRevisionResponse(rsp)
+}
+
+// DownloadWorkRevisionWithResponse request returning *DownloadWorkRevisionResponse
+func (c *ClientWithResponses) DownloadWorkRevisionWithResponse(ctx context.Context, workId WorkId, revision Revision, reqEditors ...RequestEditorFn) (*DownloadWorkRevisionResponse, error) {
+	rsp, err := c.DownloadWorkRevision(ctx, workId, revision, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadWorkRevisionResponse(rsp)
+}
+
+// ParseListTemplatesResponse parses an HTTP response from a ListTemplatesWithResponse call
+func ParseListTemplatesResponse(rsp *http.Response) (*ListTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Files []FileMetadata `json:"files"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadTemplateResponse parses an HTTP response from a UploadTemplateWithResponse call
+func ParseUploadTemplateResponse(rsp *http.Response) (*UploadTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FileUploadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTemplateResponse parses an HTTP response from a DeleteTemplateWithResponse call
+func ParseDeleteTemplateResponse(rsp *http.Response) (*DeleteTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUploadPolicyResponse parses an HTTP response from a DeleteUploadPolicyWithResponse call
+func ParseDeleteUploadPolicyResponse(rsp *http.Response) (*DeleteUploadPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUploadPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadPolicyResponse parses an HTTP response from a GetUploadPolicyWithResponse call
+func ParseGetUploadPolicyResponse(rsp *http.Response) (*GetUploadPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUploadPolicyResponse parses an HTTP response from a SetUploadPolicyWithResponse call
+func ParseSetUploadPolicyResponse(rsp *http.Response) (*SetUploadPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUploadPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadFileResponse parses an HTTP response from a UploadFileWithResponse call
+func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FileUploadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFileResponse parses an HTTP response from a GetFileWithResponse call
+func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCheckFileExistsResponse parses an HTTP response from a CheckFileExistsWithResponse call
+func ParseCheckFileExistsResponse(rsp *http.Response) (*CheckFileExistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckFileExistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Files file ids if file exists, nothing otherwise
+			Files []string `json:"files"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListArchiveMembersResponse parses an HTTP response from a ListArchiveMembersWithResponse call
+func ParseListArchiveMembersResponse(rsp *http.Response) (*ListArchiveMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListArchiveMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Files []FileMetadata `json:"files"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFileMetadataResponse parses an HTTP response from a GetFileMetadataWithResponse call
+func ParseGetFileMetadataResponse(rsp *http.Response) (*GetFileMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFileMetadataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileMetadata
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReserveIdempotencyKeyResponse parses an HTTP response from a ReserveIdempotencyKeyWithResponse call
+func ParseReserveIdempotencyKeyResponse(rsp *http.Response) (*ReserveIdempotencyKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReserveIdempotencyKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdempotencyKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IdempotencyKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReleaseIdempotencyKeyResponse parses an HTTP response from a ReleaseIdempotencyKeyWithResponse call
+func ParseReleaseIdempotencyKeyResponse(rsp *http.Response) (*ReleaseIdempotencyKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReleaseIdempotencyKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteIdempotencyKeyResponse parses an HTTP response from a CompleteIdempotencyKeyWithResponse call
+func ParseCompleteIdempotencyKeyResponse(rsp *http.Response) (*CompleteIdempotencyKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteIdempotencyKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdempotencyKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFileContentInternalResponse parses an HTTP response from a GetFileContentInternalWithResponse call
+func ParseGetFileContentInternalResponse(rsp *http.Response) (*GetFileContentInternalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFileContentInternalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUploadResponse parses an HTTP response from a CreateUploadWithResponse call
+func ParseCreateUploadResponse(rsp *http.Response) (*CreateUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UploadSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelUploadResponse parses an HTTP response from a CancelUploadWithResponse call
+func ParseCancelUploadResponse(rsp *http.Response) (*CancelUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadResponse parses an HTTP response from a GetUploadWithResponse call
+func ParseGetUploadResponse(rsp *http.Response) (*GetUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	JSON400 *BadRequest
-	JSON500 *InternalServerError
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CheckFileExistsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetUploadOffsetResponse parses an HTTP response from a GetUploadOffsetWithResponse call
+func ParseGetUploadOffsetResponse(rsp *http.Response) (*GetUploadOffsetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CheckFileExistsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetUploadOffsetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetFileMetadataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FileMetadata
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFileMetadataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUploadChunkResponse parses an HTTP response from a UploadChunkWithResponse call
+func ParseUploadChunkResponse(rsp *http.Response) (*UploadChunkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileMetadataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UploadChunkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetFileContentInternalResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetFileContentInternalResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileContentInternalResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
-func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
-	rsp, err := c.UploadFileWithBody(ctx, contentType, body, reqEditors...)
+// ParseCompleteUploadResponse parses an HTTP response from a CompleteUploadWithResponse call
+func ParseCompleteUploadResponse(rsp *http.Response) (*CompleteUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadFileResponse(rsp)
-}
 
-// GetFileWithResponse request returning *GetFileResponse
-func (c *ClientWithResponses) GetFileWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileResponse, error) {
-	rsp, err := c.GetFile(ctx, fileId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CompleteUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFileResponse(rsp)
-}
 
-// CheckFileExistsWithResponse request returning *CheckFileExistsResponse
-func (c *ClientWithResponses) CheckFileExistsWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*CheckFileExistsResponse, error) {
-	rsp, err := c.CheckFileExists(ctx, fileId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FileUploadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCheckFileExistsResponse(rsp)
+
+	return response, nil
 }
 
-// GetFileMetadataWithResponse request returning *GetFileMetadataResponse
-func (c *ClientWithResponses) GetFileMetadataWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileMetadataResponse, error) {
-	rsp, err := c.GetFileMetadata(ctx, fileId, reqEditors...)
+// ParseGetWorkResponse parses an HTTP response from a GetWorkWithResponse call
+func ParseGetWorkResponse(rsp *http.Response) (*GetWorkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFileMetadataResponse(rsp)
-}
 
-// GetFileContentInternalWithResponse request returning *GetFileContentInternalResponse
-func (c *ClientWithResponses) GetFileContentInternalWithResponse(ctx context.Context, fileId string, reqEditors ...RequestEditorFn) (*GetFileContentInternalResponse, error) {
-	rsp, err := c.GetFileContentInternal(ctx, fileId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWorkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFileContentInternalResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Work
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseUploadFileResponse parses an HTTP response from a UploadFileWithResponse call
-func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
+// ParseSetCurrentRevisionResponse parses an HTTP response from a SetCurrentRevisionWithResponse call
+func ParseSetCurrentRevisionResponse(rsp *http.Response) (*SetCurrentRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadFileResponse{
+	response := &SetCurrentRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest FileUploadResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkRevision
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -643,32 +3951,46 @@ func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetFileResponse parses an HTTP response from a GetFileWithResponse call
-func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
+// ParseDiffWorkRevisionsResponse parses an HTTP response from a DiffWorkRevisionsWithResponse call
+func ParseDiffWorkRevisionsResponse(rsp *http.Response) (*DiffWorkRevisionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileResponse{
+	response := &DiffWorkRevisionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevisionDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -681,15 +4003,15 @@ func ParseGetFileResponse(rsp *http.Response) (*GetFileResponse, error) {
 	return response, nil
 }
 
-// ParseCheckFileExistsResponse parses an HTTP response from a CheckFileExistsWithResponse call
-func ParseCheckFileExistsResponse(rsp *http.Response) (*CheckFileExistsResponse, error) {
+// ParseListWorkRevisionsResponse parses an HTTP response from a ListWorkRevisionsWithResponse call
+func ParseListWorkRevisionsResponse(rsp *http.Response) (*ListWorkRevisionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CheckFileExistsResponse{
+	response := &ListWorkRevisionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -697,20 +4019,52 @@ func ParseCheckFileExistsResponse(rsp *http.Response) (*CheckFileExistsResponse,
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Files file ids if file exists, nothing otherwise
-			Files []string `json:"files"`
+			Revisions []WorkRevision `json:"revisions"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWorkRevisionResponse parses an HTTP response from a DeleteWorkRevisionWithResponse call
+func ParseDeleteWorkRevisionResponse(rsp *http.Response) (*DeleteWorkRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWorkRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -724,22 +4078,22 @@ func ParseCheckFileExistsResponse(rsp *http.Response) (*CheckFileExistsResponse,
 	return response, nil
 }
 
-// ParseGetFileMetadataResponse parses an HTTP response from a GetFileMetadataWithResponse call
-func ParseGetFileMetadataResponse(rsp *http.Response) (*GetFileMetadataResponse, error) {
+// ParseGetWorkRevisionResponse parses an HTTP response from a GetWorkRevisionWithResponse call
+func ParseGetWorkRevisionResponse(rsp *http.Response) (*GetWorkRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileMetadataResponse{
+	response := &GetWorkRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FileMetadata
+		var dest WorkRevision
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -757,15 +4111,15 @@ func ParseGetFileMetadataResponse(rsp *http.Response) (*GetFileMetadataResponse,
 	return response, nil
 }
 
-// ParseGetFileContentInternalResponse parses an HTTP response from a GetFileContentInternalWithResponse call
-func ParseGetFileContentInternalResponse(rsp *http.Response) (*GetFileContentInternalResponse, error) {
+// ParseDownloadWorkRevisionResponse parses an HTTP response from a DownloadWorkRevisionWithResponse call
+func ParseDownloadWorkRevisionResponse(rsp *http.Response) (*DownloadWorkRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileContentInternalResponse{
+	response := &DownloadWorkRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -785,6 +4139,24 @@ func ParseGetFileContentInternalResponse(rsp *http.Response) (*GetFileContentInt
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List template files of an assignment
+	// (GET /assignments/{assignment_id}/templates)
+	ListTemplates(ctx echo.Context, assignmentId string) error
+	// Upload a template file for an assignment
+	// (POST /assignments/{assignment_id}/templates)
+	UploadTemplate(ctx echo.Context, assignmentId string) error
+	// Delete a template file of an assignment
+	// (DELETE /assignments/{assignment_id}/templates/{file_id})
+	DeleteTemplate(ctx echo.Context, assignmentId string, fileId string) error
+	// Delete the upload policy of an assignment
+	// (DELETE /assignments/{assignment_id}/upload-policy)
+	DeleteUploadPolicy(ctx echo.Context, assignmentId string) error
+	// Get the upload policy of an assignment
+	// (GET /assignments/{assignment_id}/upload-policy)
+	GetUploadPolicy(ctx echo.Context, assignmentId string) error
+	// Create or replace the upload policy of an assignment
+	// (PUT /assignments/{assignment_id}/upload-policy)
+	SetUploadPolicy(ctx echo.Context, assignmentId string) error
 	// Upload a file
 	// (POST /files)
 	UploadFile(ctx echo.Context) error
@@ -794,12 +4166,63 @@ type ServerInterface interface {
 	// Check if file exists
 	// (GET /files/{file_id}/exists)
 	CheckFileExists(ctx echo.Context, fileId string) error
+	// List files unpacked from an archive
+	// (GET /files/{file_id}/members)
+	ListArchiveMembers(ctx echo.Context, fileId string) error
 	// Get file metadata
 	// (GET /files/{file_id}/metadata)
 	GetFileMetadata(ctx echo.Context, fileId string) error
+	// Reserve an idempotency key
+	// (POST /idempotency-keys)
+	ReserveIdempotencyKey(ctx echo.Context) error
+	// Release an idempotency key
+	// (DELETE /idempotency-keys/{key_id})
+	ReleaseIdempotencyKey(ctx echo.Context, keyId IdempotencyKeyId) error
+	// Store the response of a request with an idempotency key
+	// (PUT /idempotency-keys/{key_id}/response)
+	CompleteIdempotencyKey(ctx echo.Context, keyId IdempotencyKeyId) error
 	// Get file content for internal use (for analysis service)
 	// (GET /internal/files/{file_id}/content)
 	GetFileContentInternal(ctx echo.Context, fileId string) error
+	// Create a resumable upload session
+	// (POST /uploads)
+	CreateUpload(ctx echo.Context) error
+	// Cancel an upload
+	// (DELETE /uploads/{upload_id})
+	CancelUpload(ctx echo.Context, uploadId string) error
+	// Get an upload session
+	// (GET /uploads/{upload_id})
+	GetUpload(ctx echo.Context, uploadId string) error
+	// Get upload progress
+	// (HEAD /uploads/{upload_id})
+	GetUploadOffset(ctx echo.Context, uploadId string) error
+	// Upload a chunk
+	// (PATCH /uploads/{upload_id})
+	UploadChunk(ctx echo.Context, uploadId string, params UploadChunkParams) error
+	// Finish an upload
+	// (POST /uploads/{upload_id}/complete)
+	CompleteUpload(ctx echo.Context, uploadId string) error
+	// Get a work
+	// (GET /works/{work_id})
+	GetWork(ctx echo.Context, workId WorkId) error
+	// Make a revision current
+	// (PUT /works/{work_id}/current-revision)
+	SetCurrentRevision(ctx echo.Context, workId WorkId) error
+	// Compare two revisions of a work
+	// (GET /works/{work_id}/diff)
+	DiffWorkRevisions(ctx echo.Context, workId WorkId, params DiffWorkRevisionsParams) error
+	// List revisions of a work
+	// (GET /works/{work_id}/revisions)
+	ListWorkRevisions(ctx echo.Context, workId WorkId) error
+	// Discard the latest revision of a work
+	// (DELETE /works/{work_id}/revisions/{revision})
+	DeleteWorkRevision(ctx echo.Context, workId WorkId, revision Revision) error
+	// Get a revision of a work
+	// (GET /works/{work_id}/revisions/{revision})
+	GetWorkRevision(ctx echo.Context, workId WorkId, revision Revision) error
+	// Download a revision of a work
+	// (GET /works/{work_id}/revisions/{revision}/download)
+	DownloadWorkRevision(ctx echo.Context, workId WorkId, revision Revision) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -807,6 +4230,110 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTemplates(ctx, assignmentId)
+	return err
+}
+
+// UploadTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadTemplate(ctx, assignmentId)
+	return err
+}
+
+// DeleteTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// ------------- Path parameter "file_id" -------------
+	var fileId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", ctx.Param("file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTemplate(ctx, assignmentId, fileId)
+	return err
+}
+
+// DeleteUploadPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUploadPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUploadPolicy(ctx, assignmentId)
+	return err
+}
+
+// GetUploadPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUploadPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUploadPolicy(ctx, assignmentId)
+	return err
+}
+
+// SetUploadPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetUploadPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assignment_id" -------------
+	var assignmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assignment_id", ctx.Param("assignment_id"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignment_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetUploadPolicy(ctx, assignmentId)
+	return err
+}
+
 // UploadFile converts echo context to params.
 func (w *ServerInterfaceWrapper) UploadFile(ctx echo.Context) error {
 	var err error
@@ -848,6 +4375,22 @@ func (w *ServerInterfaceWrapper) CheckFileExists(ctx echo.Context) error {
 	return err
 }
 
+// ListArchiveMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListArchiveMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "file_id" -------------
+	var fileId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", ctx.Param("file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListArchiveMembers(ctx, fileId)
+	return err
+}
+
 // GetFileMetadata converts echo context to params.
 func (w *ServerInterfaceWrapper) GetFileMetadata(ctx echo.Context) error {
 	var err error
@@ -864,6 +4407,47 @@ func (w *ServerInterfaceWrapper) GetFileMetadata(ctx echo.Context) error {
 	return err
 }
 
+// ReserveIdempotencyKey converts echo context to params.
+func (w *ServerInterfaceWrapper) ReserveIdempotencyKey(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReserveIdempotencyKey(ctx)
+	return err
+}
+
+// ReleaseIdempotencyKey converts echo context to params.
+func (w *ServerInterfaceWrapper) ReleaseIdempotencyKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "key_id" -------------
+	var keyId IdempotencyKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", ctx.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReleaseIdempotencyKey(ctx, keyId)
+	return err
+}
+
+// CompleteIdempotencyKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteIdempotencyKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "key_id" -------------
+	var keyId IdempotencyKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", ctx.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteIdempotencyKey(ctx, keyId)
+	return err
+}
+
 // GetFileContentInternal converts echo context to params.
 func (w *ServerInterfaceWrapper) GetFileContentInternal(ctx echo.Context) error {
 	var err error
@@ -880,6 +4464,269 @@ func (w *ServerInterfaceWrapper) GetFileContentInternal(ctx echo.Context) error
 	return err
 }
 
+// CreateUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUpload(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUpload(ctx)
+	return err
+}
+
+// CancelUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelUpload(ctx, uploadId)
+	return err
+}
+
+// GetUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUpload(ctx, uploadId)
+	return err
+}
+
+// GetUploadOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUploadOffset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUploadOffset(ctx, uploadId)
+	return err
+}
+
+// UploadChunk converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadChunk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadChunkParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Offset")]; found {
+		var UploadOffset int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Upload-Offset, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Offset", valueList[0], &UploadOffset, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Upload-Offset: %s", err))
+		}
+
+		params.UploadOffset = UploadOffset
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Upload-Offset is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadChunk(ctx, uploadId, params)
+	return err
+}
+
+// CompleteUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteUpload(ctx, uploadId)
+	return err
+}
+
+// GetWork converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWork(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWork(ctx, workId)
+	return err
+}
+
+// SetCurrentRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCurrentRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCurrentRevision(ctx, workId)
+	return err
+}
+
+// DiffWorkRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffWorkRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffWorkRevisionsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffWorkRevisions(ctx, workId, params)
+	return err
+}
+
+// ListWorkRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWorkRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWorkRevisions(ctx, workId)
+	return err
+}
+
+// DeleteWorkRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWorkRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision Revision
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWorkRevision(ctx, workId, revision)
+	return err
+}
+
+// GetWorkRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision Revision
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkRevision(ctx, workId, revision)
+	return err
+}
+
+// DownloadWorkRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadWorkRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision Revision
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadWorkRevision(ctx, workId, revision)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -908,36 +4755,133 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/assignments/:assignment_id/templates", wrapper.ListTemplates)
+	router.POST(baseURL+"/assignments/:assignment_id/templates", wrapper.UploadTemplate)
+	router.DELETE(baseURL+"/assignments/:assignment_id/templates/:file_id", wrapper.DeleteTemplate)
+	router.DELETE(baseURL+"/assignments/:assignment_id/upload-policy", wrapper.DeleteUploadPolicy)
+	router.GET(baseURL+"/assignments/:assignment_id/upload-policy", wrapper.GetUploadPolicy)
+	router.PUT(baseURL+"/assignments/:assignment_id/upload-policy", wrapper.SetUploadPolicy)
 	router.POST(baseURL+"/files", wrapper.UploadFile)
 	router.GET(baseURL+"/files/:file_id", wrapper.GetFile)
 	router.GET(baseURL+"/files/:file_id/exists", wrapper.CheckFileExists)
+	router.GET(baseURL+"/files/:file_id/members", wrapper.ListArchiveMembers)
 	router.GET(baseURL+"/files/:file_id/metadata", wrapper.GetFileMetadata)
+	router.POST(baseURL+"/idempotency-keys", wrapper.ReserveIdempotencyKey)
+	router.DELETE(baseURL+"/idempotency-keys/:key_id", wrapper.ReleaseIdempotencyKey)
+	router.PUT(baseURL+"/idempotency-keys/:key_id/response", wrapper.CompleteIdempotencyKey)
 	router.GET(baseURL+"/internal/files/:file_id/content", wrapper.GetFileContentInternal)
+	router.POST(baseURL+"/uploads", wrapper.CreateUpload)
+	router.DELETE(baseURL+"/uploads/:upload_id", wrapper.CancelUpload)
+	router.GET(baseURL+"/uploads/:upload_id", wrapper.GetUpload)
+	router.HEAD(baseURL+"/uploads/:upload_id", wrapper.GetUploadOffset)
+	router.PATCH(baseURL+"/uploads/:upload_id", wrapper.UploadChunk)
+	router.POST(baseURL+"/uploads/:upload_id/complete", wrapper.CompleteUpload)
+	router.GET(baseURL+"/works/:work_id", wrapper.GetWork)
+	router.PUT(baseURL+"/works/:work_id/current-revision", wrapper.SetCurrentRevision)
+	router.GET(baseURL+"/works/:work_id/diff", wrapper.DiffWorkRevisions)
+	router.GET(baseURL+"/works/:work_id/revisions", wrapper.ListWorkRevisions)
+	router.DELETE(baseURL+"/works/:work_id/revisions/:revision", wrapper.DeleteWorkRevision)
+	router.GET(baseURL+"/works/:work_id/revisions/:revision", wrapper.GetWorkRevision)
+	router.GET(baseURL+"/works/:work_id/revisions/:revision/download", wrapper.DownloadWorkRevision)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xX32/bNhD+VwhuDy2qRk7TDoXf0h/pAqxDkaDYwxAEjHS22Yikyjsl8wz/78ORkixb",
-	"dNIuXdc3mT/uPt738eN5JQtnamfBEsrpSnrA2lmE8OOVKs/gcwNI/KtwlsCGT1XXlS4UaWfzT+gsj2Gx",
-	"AKP462cPMzmVP+Wb0Hmcxfy41m+9d16u1+tMloCF1zXHkVNOJ3ybb53JU0vgrarOwd+Aj7u+B4wur8CQ",
-	"WEBcmMnfHZ24xpbfBcWJrkBYR2IWUvKCdhtH7XdOV7L2rgZPOnJWAildhU9a1iCnEslrO+cDQLdlNGMA",
-	"Uc0hMbfOuhF39QmKwAxjew+kSkVqjEAh6rk1YOlSl8lsxQKKa2xMxDs89fs3L4Tz4vzX42cvfhH9uiwR",
-	"IxJwGScSSWa6gn0AeM4qk96I+m+4vFoSDIuoLcEcggyQmvKOwzV15VQJ5aUK6pg5b/hLlorgKWkDqdPc",
-	"On+dDriv/h9DmrP2uo5ZGBx/u8Qfrf7cgOB5ofkgeqbBp0B9RZWMttowoZMsWTHn1Rwua0WLMaAPihbi",
-	"dgG+A4WCN0ApHlWuUFUQxNHjFMKH1nobyB/OXw9KIh4VHhRBKWbeGbGhXTwRWxoXTwTnQlKmTsBcZ5Jd",
-	"TXso5fTPnpgNkIsRxbxH25kbY2Qz1AWImfOhStrOhbLsm+Q13PBPToAMQ1MFnZOcRwpEt//4w6nM5A14",
-	"jHEPDyYHE66Oq8GqWsupPDqYHBzJTDJrgeQ8Rmapufgk7CgrsIFCRR4ZViAShSahraAFiFYKApdIwBeb",
-	"NRu887TsQzBiGasGSK9cudyxXNNUpGvlKWfKn3Y+tHHdexzJaPsb2Dmr8XCP8Lf0dKWt8suUmLbN4M64",
-	"OzoY7Mx2ELYI0rrYxCDfQBgYPNnPJoff7HlK2My+h6q7iAKbogDEWVNVS67P88lkX5oedz7oM3jL4dFY",
-	"WyELOScq5efAy158SeRUDxGe0sYYZrRTXKtZZljNkek5CVq/4MVR9/mqvblrTjuHUNxt9b4DaqVbK68M",
-	"EHiOtZKajxDcL5PRUQc2sE1oNiBnV0AXI7InD7sYhWrru3rAJVgnZZqgr8MZZPH8fvL6fmubsTfu1n41",
-	"Zzn8pTG2uC112wDPgBpvsXsWUbiZQG10pXw0VHGlEErh7LAr2ab/NU8whrcx1/8ng7vv/LhRwHFB+kLo",
-	"WSxKLGDGPemCnxlHC/C3GpkATWDSLWc7oLxXy+RTiHtsLqGfgABsAZEC4QGbiv6ly3wj+wic79ToCxVp",
-	"Bh30XXbSd9o/qp7ue0P6A+wjtq/EA53hHVDkwWxKlmJCt7SOKBkc+S5GXsdlnTp+EGJcQUBPkTwos03Q",
-	"/Rb+nxp2T0sbLzSvHQeiQRCPeERZVS2Ru//Yoz5OsceBw2WMhU6A3ul0ZSYbX8mpXBDV0zwPfykWDmn6",
-	"cvLyMJdc5DZLMlzXsPZCwA2nEdX6Yv1PAAAA///+uAWDRhEAAA==",
+	"H4sIAAAAAAAC/9xde1PcOLb/KirfW7WwY7rJs3aZvxhCMtyBwAUyU7PTU5TaPk1rcUseSQ7pTfHdbx09",
+	"bKstdzekIcn9ZyZgW4/zPr9zJD4nmZiVggPXKtn7nJRU0hlokOanoxxmpdDAs/kvMD/K8XeMJ3tJSfU0",
+	"SRNOZ5DsJTcwv2J5kiYS/qqYhDzZ07KCNFHZFGYUv9LzEt9UWjJ+ndzdpck5fGSKCd4zpvSPl406Y5zN",
+	"qlmy9yz1MzCu4RqkmeI3IW96F30r5M19V32HL6tScAWGPj/R/Bz+qkBp/CkTXAM3/6RlWbCMaib48N/K",
+	"brIZ9r8lTJK95L+GDe2H9qka7pfsUEoh7Ww5qEyyUhs64XREuvnu0uSIa5CcFhcgP4K0Xz3FMvy8RJmJ",
+	"CdgX0+S90G9FxfMnWcVbVgDhQpOJmfIuTT5wVZWlkBryE8gZvTTce6qluFkIygxhyiyNFoW4hZwIaR5L",
+	"mrNMK6KnQHLICiohN+8baXUz4ULqyVAjpShBamYlLgdNWaEiwpkm4D8JV2dGIpnIISUwuB6Qw5Ozy9+v",
+	"3h4dH6YE/3t1eXp6dbx//u4wJUfvf90/PnpztX9+8PPRr4fpiJ8f/np0cXT6/ur96eXV8f7l4cWl/+z3",
+	"s0Pz2/3j49PfDt+k5OD0/eXh+0v75OTo4mT/8uDndMT9qB/Ojk/331ydnR4fHfyekuBHM9Lb0w/v37Q+",
+	"OHpzeHJ2enn4/uD3q18Of0/Jwi/CjxaeHb2/Ojs/fXd+eHHR/dCvbsSTtEvKGShFryFuudxvxPjfkBlF",
+	"PJBANXwoCxHYg5BzVCl2zWfANVoda7yOgV/radt8NUtw8nSlnRR3XpiwAqwtWzmWYv+Bq/Fcg+qKx6XQ",
+	"qMrsP0DExIgmDpykyUTIGdXWpL5+maRLrW2aKF3la27urm1z/2h/mS6QqbXLYBd/RriAWngwpfwausTP",
+	"2WTS3foHziYMcoJPcfOUzERuf6Xhk/aE6JAT379SN6wsIe+O+tt0Triwg95SRcYVKzTZGjNO5dyMmRIt",
+	"BCmovHb/nFE+J5lZu9qOzTiRYnaFnzrydl4oGAd1RfMc2s9b7LEvSJiJj32vGP/Y2c4Z1VPCuGI5GOmg",
+	"Mpuyj4A2bWJMsGVOV+Y01ZUhPnAUmj8Su7o08YtIE0/uJE0qbvefJ39GBtNiye4XxMm5eTd/n6ScgKY5",
+	"1XQNRe2q5hSyG1XNusQ6efMKCXPx8/7zV69J/d5D1btvAW3V7zxk6srxKKLssgIyEZJUxlhB7tmpyO1U",
+	"KKv7ilAJRGmB7okqMoPZGKRqdjEWogDK3WRZJSXuRLYCusisrDEuZAyF4NeKaGF+50YgfgTURaYVwRCt",
+	"b1YNs7KgetkeG0YS/7bdX3RMu8urJUrQMo8RhYgxuaSGMi1WhsPuO12qh0V7UfGSZjeQE1T62Kj9hD5v",
+	"ERDHRALGqJ5EDXjgJDrWf5XF7yzTi9gV1cGAOdWwo1ncaviofD3Hi4rs3a4Ny7vq3Ev8D5z9VXl24kbQ",
+	"FMmo9V2mbk/Cjdr17sYZISS9hh7Z/Qmliec7f1W0sM7tBuZ+TU7LnTUakAP7Dwxg3SPBMxjxEoxV20Gz",
+	"RnlO1NQEr+M5xrjOatwyPbWD0hnUxi8dcRN1FiKjxd5wSMdDOn72fDAYoKVUL/aGw3GV3YBuPYkHZV8q",
+	"UAtuGrnR8J1sZSaKs2pHGtkmP5DAI5AfCM6lNJ2VEV+94Iy89DULiTmkMM/uyrBb2r02Dp9KJkHd65tl",
+	"Pscl+bFHhUB7dVVxzYqYnmlWkNspcEJJCTxn/NqIIFNkCoURIrT2PrNN11yshFLIXuvjRruaUjXtecFa",
+	"jKuxyOfL32hCmZjuLYY5botJaqCVAnRPTLPU1LVlKIBX6j3VU6dt8Qj4vlrUerOVhtshMw8KhubhBuYp",
+	"qawBRbVn3LBQ3HJjQWf0k4/+n796la7mTjjJz/CpNjYTxq9BlpJx7W1WIyiteV6/TNtJx+uXnVnXouo6",
+	"JFvD0zy6sC56GvuY4GOy9T8Xp++3iQRdSY65jCASyoLOFUZzTMWVqSPrCzy5vDwj9mHDB/tN1H+tLd0L",
+	"Qr24kMW9xxjkHe0bl+UtGE+bV+E/mYaZWoX0tPLIJuagUtJ5nYm1fX5361qseGFt2jQYZThtOEdabzFG",
+	"HBsfnYmCZRHP4hAqk4aEJOrmYAuUWCNPeoDbmtFPNl7GAGjNQLQq83vOs0DnRdAhpMqCgW3Ntore/WDQ",
+	"ItkX8gL7OMAUVWr+N1TVGP+PwZP5+e9ky8RXbWizzCfpiCOGMfz79oAczko9xw9mTCn0vmZ6RRB1wDFs",
+	"vAWfKLorQ49wLKSfGQs3vL6AdFi5kCvTTxjT2mgYXyGMExPx/mijSJAfWQY7t5hoFWzGNOaQbgv3xafu",
+	"ejl1Acpr6n1xgIeGWL25hJhMFOhICI9UIRIyYB8hJ0qQCZUxEjxVVreW9WperXcWrGdlrIIh+oPwmQfY",
+	"nRiK0SXOAvDQRRHwiVo1ygoSP8A9LIVQ24vurjCy9YCCfYxp1++6cVDEqH3wkJN5/mMNJDojZ1Gngilt",
+	"88p3h5dkaF4dfnaR1d2wgaLWduU10BexUA16FefmcjZuHuCIM7fFl9aCw/lTR/Uut3Bcxieiy5ALa2AN",
+	"Vqa0kMYz8JxI0JLBR/yxhsuYRs9gK10XFm4g/vv9s6MkTT6CtKRKng12B7vGnJXAacmSveTFYHfwIrEQ",
+	"s+HasBFSNfwcSOzd0EurefPaGkQUMOOQsKCbHDOlL+u3Fqqyz3d371X265HeDUhYBAzoY9EChhnilXdp",
+	"8mp3t28t9eaHsYowDq6q2YzKuSPcAhpqKh+8BXIgv+m1whU3NP7zLg2aA/6IFtUXTc/6pfU/06QUKuL7",
+	"UNpAEUrG1OHTZEvdQAFacFfVDIDeT3qb6CnVBD6CnHsgZ8SVplIrg+4MyAnV2bSGrEJyoBniQpNMVFxb",
+	"BLws6DWjkqnZYMRPeTHvBigmpNpB82UjMbDotqefDbFCIbYG8bIxzS4V+snleC0BnlWFZiWVeohWZscX",
+	"LpbJcGCSbPFpLbSqRz5DTt51VO7ZxirtEVB3mYbUlQxVZRkoNamKwhj3l+toTKuDAz959qIrgMbm1QU7",
+	"+9qr1SNHuxE2p8iWQoSG0mvrHmso8126pglunK+lTAE29AlF+Y35fUuUH9NUpNHxGqT1XkZnQYxfRupJ",
+	"nsB287mVrZermVi3w2yO65bOHa6LyUaYblVpp6yxguXsDpCFdSjpZNaO/w2RE415FaxtCUHNfpnziNHg",
+	"5B3o5aTZ3ZitDOaJWMmA4l+T0u9AfwGZHzvwqHQUU9XStGu5EMKtXRkbi3tphx22kO2yF8faEce5lImp",
+	"tSABHGKBjgG5nIInBqdSIiqzGFqMuAE/7DgcTMfdJw08N6/OYpHFRUQC+0KLzQhf7UTXiRW+kvwTRT96",
+	"e3Pv0GBDemBbxYiQFpLPHm580JjXuUo8dP7gBJZaL2HqtjaaZpowXheAMZlTc6VhZgXSCYutJjCFsbME",
+	"OrOlhJypm5Qo0S6R4shhkXRWKU1KCRnkdcfEiOOEGJN6SLIJuCVgqAn5gPzr6Cwll/vnZtDL/fPBu381",
+	"TSpUwoj7Bok9F+JzwXegHs+3ZjQVbIoUsMiBK12MuBvRJgCudFWXrBXZUgBLEYjtwYjv160zOEjFFZ0A",
+	"MRku8hc+ZQCm1om7dtObnAKf+j2MuLEGXsNblLCjLvRjDkZ8xA/Nrp3MuLSGEg63QQONZa5h0d9sK01K",
+	"bqcsm474GDIxAxX03ggOA3IpiKrGiLQqJC31lX2q8Hk9fDriJVXKFYHclHW5yZvHCZNKu7YW3xkg9BSk",
+	"+pEIXsytOFg4qhkmo5yMwVk4yMnWy91/kkgP6rYhBUrrYuNtDtoS0BTy634L/9rWjF6zzNrf7ZRwgXaa",
+	"3gBv3s9MkXOP0LBLF/nmVMksz6wXU80RX+zuzZo2ipCf8Q5ZQq2vIKLSdV9RkF6G7UytZBMldcTXD+u2",
+	"7TqiDbwDS9DWRI1w1lktVeQWisJaiprWnvhW5UacKtLucOtPf9/aHsuNpb73bbNdO1Ve1ulzvCDEQcOP",
+	"IDTPGznUYkWp4lFbab+T7B7f2mxmv/vPJzkKcNk2ie4UQMzMtSTkewUefHe0C03eWnCziUtC5OAaotge",
+	"xhWqUQ7Xe2VCkgG5sPtQ5BzL2z4sUWTr+e7rbYwPjOHNGY5Gi/r5Hjma7LwXHHYMzNd4n8NLeo2W9Giy",
+	"c+I6j3cumOlvM+8cU6XrJ+QaNHmx+9J2LtUrnFLLVNetHDNs70A7q7Y6b9kMarEsoBaZBr1jI7hQxlej",
+	"g0tPuiRpMgWau6Ni+1kGpd45r3stlp39QkZ0peF/K4GuxHf++GjM60oz7bKhAx72QhCazRaPO/QPisM+",
+	"3339VDR2VstUwTQQaWS/tVayZdXBEn87ZIPr37R8WJcNy7f+Igbl1Kev6hMTW6HSxdSMzPAR5NsPQiJe",
+	"Pnsdy9I9sSydcEmKaqYmjI4LWMR7xC2/t/UawiemtOo1Yucm8FW+kdjUU0yc68Nv9bdog+yinLvoq4nZ",
+	"MSUBKgsGssYeDJYQr1f4ADxmkg5wDtzjod3Lt2Ga1i7FhQSvCc0mPhrHTZlYfooJl6H+LTOdaeu2qzy4",
+	"VPe2XgGgiBtuEgmqKvRXhhrMSkIarSnxvsy+rPzqst+TuiL/XUnU1yzuOsr5czVEyBxcMz3Si2w5MENI",
+	"A2148OMhVrNb+7U2IzhjYtCm5hDLWvLRHJ7qg8AD4n2r0rE+93sUf9YSjy/hDeLjk2DAOCdY04u8cwPz",
+	"ZeCfsiKF/uaaarilc4tC37jE1CA9yiYqflQ9IPsGSbL9+SMuwRzyblAdjw1uPd99tu0gRewstmcAEGEq",
+	"oP3iiMuKqwE5Cnq3G1eIE00NWuB65VMDxznoztNtxJmqHRzOvbuNK8WP7bk1nN6iqrgL3FMJkoncjG3b",
+	"zHI3iNsQvaaMx3zluX1j4UzG40Do8W78JwbRF3baH5ISWkig+Rxpi4GtZ5ZfWQMAJXfpRlGD1Uv8BeY1",
+	"b9NA1AwUPYZm0d84hLAfVxJDW1YUhHFSSnEtQZnGoJfPnz8ZsmH0DY8oKo9nUnPOGdzxzU2HME4VCW2Z",
+	"qGyOy2iZx5Zs9BjJ4Wd72GOhhSHcn60FI5Jem4jGvHiOTCgrUL6UaAHFBrOWoOXc4Qh1iH8DczxS5ywb",
+	"/mjD9RsoNTHHpfDduTNQcWNUAFVdY7TgTmOEbl4Zdu5xWa/zweqUWcBXLdM7ImxMDOrJjfeMFX89z1St",
+	"frVQhBxun14ZkHN3zMZXmdxT57mInrKWsaz533VaVh6iTWN+ZY8jEY/v4dqY77fl4hxbGgf2IB/xdTTE",
+	"NEkGh7LsfRaBJ7mv+rhZO9F/iyPLgn8HRfnF/7+HQzeVAbjxTMTteYAel2xNTHMfLeaKKV8e3O5JFBxg",
+	"1J8fXPjCcTatOIbu9gsrN76rrDmt4RtWDvBt68WU6XExonWGZUziJx1+ro9+3PmzPDbRcIYNUQmmXX0E",
+	"QXzvIRm30OyWrexTAypjRw3OWre9ML1ty4+4PAuJobWti7VBRZhKB8r4mF/pxe6doP1ixKsS02KufwxL",
+	"uVpBMcE1+9FM/7FZORPRTKJ9L9AjJRCxq4eeuKIXHqPqb8LxMuDOloS49bGwc0fy2PNjj35XwUArCgF2",
+	"1p3T+khVRNP7TkHd3T3Q/H8/ZTzXiGSi3WqGWHmXvN6ufHC2JLAsbSVf1it6QHkGRUsJ1uwS9eJiT6S4",
+	"k2+ZtT5f0jr6lJVgR1GmyBgQmK4N3SYZaeiLzr3yNO7ybVWn6uP3qK5tH752l2pNyKWKYI1XfzEIzRWv",
+	"fMvZOHZ+E51dYKQGZJ+PuHH5sjJur5Efo6UW0FPuCg0zBV5TZp2j616imtizlj2VaTvjqT+OGeN6lDs1",
+	"5BCYbbf+Q+vWux9fojOnEw3S9p4Z6vIJ40xNodFxs0F/KdgaRt33w6x9l919bH/Edax3Gvf+Dma19asv",
+	"uLQthQbHjAht1WFSTGRXx9/tI7v3a6HGym7kIHtZ+kblsKvUNUzb+QahHtSIHeCVRUSLEY+pkwcjPSPS",
+	"pu5HsGmwjRibXpEWYqOmoipyQtVNDWxbrbGZ0s+H+2/6W9ZMCNyTzljdaAgaitIyovYfZ4/c+bR+tm63",
+	"9cMXpj2rosmIIBsq1RH8KrNxr0jusdTx8dP9pwo+Qn3KBdimJdN/4ZTRkcvoUkqEbOljT8ASjW4PXGaW",
+	"AeQLN9vWFzs8RvdZ5pRw7Rh16PeyLCU2zfEBfSa+nmXgPiyiUUXOTi98a7iLT9F5KZuGhtHDgOwXRSeP",
+	"tiZuSj/iPXDA6+mWIX51pPaIZvxbaPtsA3DftHr5/k4vWGQO2mgS5bb/x3s8Vpc3UaeExIJBBkWBP7Hv",
+	"LXF8a4K3FfkG6iAWmdXws7vHYFkTqGl4Co5xW6VyvbKlMDGxPahuateDWGT7m70t9H44uLsi/lEbCczC",
+	"IlL0m2/+/ZrZTn3JquMhLqqHg0N3VmSn3QgfLZ+cUIkYYdO+VvOSdk6duEvJtSBSFAWehGg1JoxpdmOh",
+	"PpPpONPqz6/4UXpL+RegD+w7580dGl8gIA/D78IWpDbxVtxYFF6T5j57WAP/ZqW5pmYP7oEdJIuX+j5N",
+	"RSUQ8BN6YxEuf7aovjplHWn3d4ZHjRY6ZSpBNVdo6FtRz6QG5G2szyo4xuaaYV2PDpO2+at7sbDLa9xM",
+	"47m5cXsw4iedu8oVqUpUpGfk5CfTvk5J1briPKYheGVem6PqwQriT+X/VYHJInxFx15i/NC/4REfVIuH",
+	"D/mYlj64iDCiG/ZaQQyu9S24UwW1zHwFBXFSHMpu42bXVJRmB8v6RTcjZZttBw1WvlZLaGj9VrSENsOv",
+	"0xZaEyf1p4XMCcqNdH1ugLnDz/6f67XRFEXLNnZPQDm3T9ttiKbXpk6gbK8NmdJickvnrQ5CZ82xEclZ",
+	"dNvwVeIjUZmuRf8OojnOcIZBAzZruJcssNRupK9UPBWzmwtk4AvM5Yo3GzFbq1mn3s73Uhx5wDG5TV37",
+	"wVRGZd43Y69+9FdRvgGReLoI7zzQLqZVc1Kzvq7sSzsh6HocWddiDXN38mflKZ4GBXPb8X+4ol7P1J2G",
+	"t3/JpcGcKV5dsHCzQMcK+lGi1sWt8RsXps130AiJpFu8EPJLxah12Gs9WcKvjc2whI4sdOHmwyRNKlkk",
+	"e8lU63JvODR/4mAqlN77x+4/ng0NYd0sn/uQLhyuFgPVhLhvnSZ1KiqRP2qyhVfjbdeXNboRmpufuqOc",
+	"10X/sPtHLVYuYh8jwVpha/2FJeTSJbc7bVh7sfXFJt3Pj8KeNaNK/kCDP/LbDNR6O7n78+7/BgBTxujY",
+	"4HAAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
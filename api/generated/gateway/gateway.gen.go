@@ -20,6 +20,10 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ApiErrorError.
 const (
 	ApiErrorErrorInternalError      ApiErrorError = "internal_error"
@@ -28,10 +32,20 @@ const (
 	ApiErrorErrorValidationError    ApiErrorError = "validation_error"
 )
 
+// Defines values for FileChangeStatus.
+const (
+	Added     FileChangeStatus = "added"
+	Modified  FileChangeStatus = "modified"
+	Removed   FileChangeStatus = "removed"
+	Unchanged FileChangeStatus = "unchanged"
+)
+
 // Defines values for ReportStatus.
 const (
-	Completed ReportStatus = "completed"
-	Failed    ReportStatus = "failed"
+	Completed  ReportStatus = "completed"
+	Failed     ReportStatus = "failed"
+	Pending    ReportStatus = "pending"
+	Processing ReportStatus = "processing"
 )
 
 // ApiError defines model for ApiError.
@@ -45,16 +59,60 @@ type ApiError struct {
 // ApiErrorError defines model for ApiError.Error.
 type ApiErrorError string
 
+// CreateUploadRequest defines model for CreateUploadRequest.
+type CreateUploadRequest struct {
+	AssignmentId string  `json:"assignment_id"`
+	ContentType  *string `json:"content_type,omitempty"`
+	Filename     string  `json:"filename"`
+
+	// SizeBytes Total size of the file
+	SizeBytes int64  `json:"size_bytes"`
+	StudentId string `json:"student_id"`
+}
+
+// FileChange defines model for FileChange.
+type FileChange struct {
+	// Diff Unified diff of a modified text file
+	Diff *string `json:"diff,omitempty"`
+
+	// DiffSkipped Why no diff was built (binary file, too large, too many changes)
+	DiffSkipped  *string `json:"diff_skipped,omitempty"`
+	FromFileId   *string `json:"from_file_id,omitempty"`
+	LinesAdded   *int    `json:"lines_added,omitempty"`
+	LinesRemoved *int    `json:"lines_removed,omitempty"`
+
+	// Path Path inside the archive or file name
+	Path     *string           `json:"path,omitempty"`
+	Status   *FileChangeStatus `json:"status,omitempty"`
+	ToFileId *string           `json:"to_file_id,omitempty"`
+}
+
+// FileChangeStatus defines model for FileChange.Status.
+type FileChangeStatus string
+
 // Report defines model for Report.
 type Report struct {
-	AnalysisDurationMs *int       `json:"analysis_duration_ms,omitempty"`
-	AssignmentId       *string    `json:"assignment_id,omitempty"`
-	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	AnalysisDurationMs *int `json:"analysis_duration_ms,omitempty"`
+
+	// AnalysisMode How the file was tokenized: "text" for prose or "code:<language>"
+	// for source code (comments stripped, identifiers and literals normalized).
+	// "archive" for ZIP/TAR submissions and "multi-file" for submissions of
+	// several files: every file is compared separately and
+	// plagiarism_score is their word-weighted average
+	AnalysisMode *string    `json:"analysis_mode,omitempty"`
+	AssignmentId *string    `json:"assignment_id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
 
 	// ErrorMessage Error message if status is failed
 	ErrorMessage *string `json:"error_message,omitempty"`
 	FileId       *string `json:"file_id,omitempty"`
 
+	// FileIds Files covered by the report if the work has several, file_id first
+	FileIds *[]string `json:"file_ids,omitempty"`
+
+	// IsLatest True for the most recent analysis version of the file
+	IsLatest *bool `json:"is_latest,omitempty"`
+
 	// IsPlagiarism True if plagiarism detected
 	IsPlagiarism *bool `json:"is_plagiarism,omitempty"`
 
@@ -62,58 +120,176 @@ type Report struct {
 	PlagiarismScore *float32 `json:"plagiarism_score,omitempty"`
 	ReportId        *string  `json:"report_id,omitempty"`
 
+	// ResubmissionOf Set when the file is a resubmission: id of the same student's earlier
+	// file it matches best. Own earlier files are listed in similar_works
+	// with is_self_match and are not counted in plagiarism_score
+	ResubmissionOf *string `json:"resubmission_of,omitempty"`
+
 	// SimilarWorks List of similar works found
 	SimilarWorks *[]SimilarWork `json:"similar_works,omitempty"`
-	Status       *ReportStatus  `json:"status,omitempty"`
-	StudentId    *string        `json:"student_id,omitempty"`
-	WordCount    *int           `json:"word_count,omitempty"`
-	WorkId       *string        `json:"work_id,omitempty"`
+
+	// Status pending - queued for analysis, processing - analysis in progress,
+	// completed - analysis results are available, failed - see error_message
+	Status    *ReportStatus `json:"status,omitempty"`
+	StudentId *string       `json:"student_id,omitempty"`
+
+	// Version Analysis version of the file; re-analysis creates a new version
+	Version   *int    `json:"version,omitempty"`
+	WordCount *int    `json:"word_count,omitempty"`
+	WorkId    *string `json:"work_id,omitempty"`
 }
 
-// ReportStatus defines model for Report.Status.
+// ReportStatus pending - queued for analysis, processing - analysis in progress,
+// completed - analysis results are available, failed - see error_message
 type ReportStatus string
 
+// RevisionDiff defines model for RevisionDiff.
+type RevisionDiff struct {
+	Changes      *[]FileChange `json:"changes,omitempty"`
+	FromRevision *int          `json:"from_revision,omitempty"`
+	ToRevision   *int          `json:"to_revision,omitempty"`
+	WorkId       *string       `json:"work_id,omitempty"`
+}
+
+// RevisionFile defines model for RevisionFile.
+type RevisionFile struct {
+	ContentType *string    `json:"content_type,omitempty"`
+	FileId      *string    `json:"file_id,omitempty"`
+	Filename    *string    `json:"filename,omitempty"`
+	IsArchive   *bool      `json:"is_archive,omitempty"`
+	SizeBytes   *int64     `json:"size_bytes,omitempty"`
+	UploadedAt  *time.Time `json:"uploaded_at,omitempty"`
+}
+
 // SimilarWork defines model for SimilarWork.
 type SimilarWork struct {
+	// IsSelfMatch The similar file is the same student's earlier submission
+	IsSelfMatch *bool `json:"is_self_match,omitempty"`
+
+	// OriginalPath Path of the matching file inside the analyzed archive
+	OriginalPath *string `json:"original_path,omitempty"`
+
+	// SimilarPath Path of the matched file inside the similar work's archive
+	SimilarPath          *string  `json:"similar_path,omitempty"`
 	SimilarityPercentage *float32 `json:"similarity_percentage,omitempty"`
 	StudentId            *string  `json:"student_id,omitempty"`
 	WorkId               *string  `json:"work_id,omitempty"`
 }
 
+// SubmittedFile defines model for SubmittedFile.
+type SubmittedFile struct {
+	FileId    string  `json:"file_id"`
+	Filename  *string `json:"filename,omitempty"`
+	SizeBytes *int64  `json:"size_bytes,omitempty"`
+}
+
+// UploadSession defines model for UploadSession.
+type UploadSession struct {
+	AssignmentId *string    `json:"assignment_id,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	Filename     *string    `json:"filename,omitempty"`
+
+	// Offset Bytes received so far
+	Offset    *int64  `json:"offset,omitempty"`
+	SizeBytes *int64  `json:"size_bytes,omitempty"`
+	StudentId *string `json:"student_id,omitempty"`
+	UploadId  *string `json:"upload_id,omitempty"`
+}
+
+// WorkRevision defines model for WorkRevision.
+type WorkRevision struct {
+	Files      *[]RevisionFile `json:"files,omitempty"`
+	IsCurrent  *bool           `json:"is_current,omitempty"`
+	Revision   *int            `json:"revision,omitempty"`
+	UploadedAt *time.Time      `json:"uploaded_at,omitempty"`
+	WorkId     *string         `json:"work_id,omitempty"`
+}
+
 // WorkSubmissionResponse defines model for WorkSubmissionResponse.
 type WorkSubmissionResponse struct {
-	// FileId Uploaded file identifier
-	FileId      *string    `json:"file_id,omitempty"`
+	// FileId Identifier of the first uploaded file
+	FileId *string `json:"file_id,omitempty"`
+
+	// Files Every stored file of the submission
+	Files *[]SubmittedFile `json:"files,omitempty"`
+
+	// ReportId Identifier of the queued analysis report. Absent if the file was
+	// stored but the analysis could not be queued.
+	ReportId *string `json:"report_id,omitempty"`
+
+	// Revision Revision of the work created by this submission
+	Revision    *int       `json:"revision,omitempty"`
 	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
 
 	// WorkId Unique work identifier
 	WorkId *string `json:"work_id,omitempty"`
 }
 
+// WorkId defines model for WorkId.
+type WorkId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ApiError
 
+// Forbidden defines model for Forbidden.
+type Forbidden = ApiError
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = ApiError
 
 // NotFound defines model for NotFound.
 type NotFound = ApiError
 
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = ApiError
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ApiError
+
 // SubmitWorkMultipartBody defines parameters for SubmitWork.
 type SubmitWorkMultipartBody struct {
 	// AssignmentId Assignment identifier
 	AssignmentId string `json:"assignment_id"`
 
-	// File File to upload and analyze
-	File openapi_types.File `json:"file"`
+	// File Files to upload and analyze (the part may be repeated)
+	File []openapi_types.File `json:"file"`
 
 	// StudentId Student identifier
 	StudentId string `json:"student_id"`
 }
 
+// SubmitWorkParams defines parameters for SubmitWork.
+type SubmitWorkParams struct {
+	// IdempotencyKey Client-generated unique key of the submission (e.g. a UUID)
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// UploadWorkChunkParams defines parameters for UploadWorkChunk.
+type UploadWorkChunkParams struct {
+	// UploadOffset Number of bytes already received (from HEAD)
+	UploadOffset int64 `json:"Upload-Offset"`
+}
+
+// SetCurrentRevisionJSONBody defines parameters for SetCurrentRevision.
+type SetCurrentRevisionJSONBody struct {
+	Revision int `json:"revision"`
+}
+
+// DiffWorkRevisionsParams defines parameters for DiffWorkRevisions.
+type DiffWorkRevisionsParams struct {
+	From int `form:"from" json:"from"`
+	To   int `form:"to" json:"to"`
+}
+
 // SubmitWorkMultipartRequestBody defines body for SubmitWork for multipart/form-data ContentType.
 type SubmitWorkMultipartRequestBody SubmitWorkMultipartBody
 
+// CreateWorkUploadJSONRequestBody defines body for CreateWorkUpload for application/json ContentType.
+type CreateWorkUploadJSONRequestBody = CreateUploadRequest
+
+// SetCurrentRevisionJSONRequestBody defines body for SetCurrentRevision for application/json ContentType.
+type SetCurrentRevisionJSONRequestBody SetCurrentRevisionJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Download a file
@@ -122,12 +298,42 @@ type ServerInterface interface {
 	// Health check
 	// (GET /health)
 	HealthCheck(ctx echo.Context) error
+	// Side-by-side comparison of a work and a similar work
+	// (GET /reports/{report_id}/similar/{similar_id}/diff)
+	GetReportDiff(ctx echo.Context, reportId string, similarId string) error
 	// Submit a new work for analysis
 	// (POST /works)
-	SubmitWork(ctx echo.Context) error
+	SubmitWork(ctx echo.Context, params SubmitWorkParams) error
+	// Start a resumable upload of a work
+	// (POST /works/uploads)
+	CreateWorkUpload(ctx echo.Context) error
+	// Cancel an upload
+	// (DELETE /works/uploads/{upload_id})
+	CancelWorkUpload(ctx echo.Context, uploadId string) error
+	// Get upload progress
+	// (HEAD /works/uploads/{upload_id})
+	GetWorkUploadOffset(ctx echo.Context, uploadId string) error
+	// Upload a chunk of a work
+	// (PATCH /works/uploads/{upload_id})
+	UploadWorkChunk(ctx echo.Context, uploadId string, params UploadWorkChunkParams) error
+	// Finish a resumable upload and submit the work for analysis
+	// (POST /works/uploads/{upload_id}/complete)
+	CompleteWorkUpload(ctx echo.Context, uploadId string) error
+	// Make a revision of a work current
+	// (PUT /works/{work_id}/current-revision)
+	SetCurrentRevision(ctx echo.Context, workId WorkId) error
+	// Compare two revisions of a work
+	// (GET /works/{work_id}/diff)
+	DiffWorkRevisions(ctx echo.Context, workId WorkId, params DiffWorkRevisionsParams) error
 	// Get all reports for a work
 	// (GET /works/{work_id}/reports)
 	GetWorkReports(ctx echo.Context, workId string) error
+	// List revisions of a work
+	// (GET /works/{work_id}/revisions)
+	ListWorkRevisions(ctx echo.Context, workId WorkId) error
+	// Download a revision of a work
+	// (GET /works/{work_id}/revisions/{revision}/download)
+	DownloadWorkRevision(ctx echo.Context, workId WorkId, revision int) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -146,6 +352,8 @@ func (w *ServerInterfaceWrapper) DownloadFile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file_id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DownloadFile(ctx, fileId)
 	return err
@@ -160,12 +368,217 @@ func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
 	return err
 }
 
+// GetReportDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "report_id" -------------
+	var reportId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "report_id", ctx.Param("report_id"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter report_id: %s", err))
+	}
+
+	// ------------- Path parameter "similar_id" -------------
+	var similarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "similar_id", ctx.Param("similar_id"), &similarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter similar_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportDiff(ctx, reportId, similarId)
+	return err
+}
+
 // SubmitWork converts echo context to params.
 func (w *ServerInterfaceWrapper) SubmitWork(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitWorkParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitWork(ctx, params)
+	return err
+}
+
+// CreateWorkUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWorkUpload(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWorkUpload(ctx)
+	return err
+}
+
+// CancelWorkUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelWorkUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelWorkUpload(ctx, uploadId)
+	return err
+}
+
+// GetWorkUploadOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkUploadOffset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkUploadOffset(ctx, uploadId)
+	return err
+}
+
+// UploadWorkChunk converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadWorkChunk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadWorkChunkParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Offset")]; found {
+		var UploadOffset int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Upload-Offset, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Offset", valueList[0], &UploadOffset, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Upload-Offset: %s", err))
+		}
+
+		params.UploadOffset = UploadOffset
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Upload-Offset is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadWorkChunk(ctx, uploadId, params)
+	return err
+}
+
+// CompleteWorkUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteWorkUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "upload_id" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upload_id", ctx.Param("upload_id"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upload_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteWorkUpload(ctx, uploadId)
+	return err
+}
+
+// SetCurrentRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCurrentRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCurrentRevision(ctx, workId)
+	return err
+}
+
+// DiffWorkRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffWorkRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffWorkRevisionsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SubmitWork(ctx)
+	err = w.Handler.DiffWorkRevisions(ctx, workId, params)
 	return err
 }
 
@@ -180,11 +593,57 @@ func (w *ServerInterfaceWrapper) GetWorkReports(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetWorkReports(ctx, workId)
 	return err
 }
 
+// ListWorkRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWorkRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWorkRevisions(ctx, workId)
+	return err
+}
+
+// DownloadWorkRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadWorkRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "work_id" -------------
+	var workId WorkId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "work_id", ctx.Param("work_id"), &workId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter work_id: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadWorkRevision(ctx, workId, revision)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -215,39 +674,108 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/files/:file_id", wrapper.DownloadFile)
 	router.GET(baseURL+"/health", wrapper.HealthCheck)
+	router.GET(baseURL+"/reports/:report_id/similar/:similar_id/diff", wrapper.GetReportDiff)
 	router.POST(baseURL+"/works", wrapper.SubmitWork)
+	router.POST(baseURL+"/works/uploads", wrapper.CreateWorkUpload)
+	router.DELETE(baseURL+"/works/uploads/:upload_id", wrapper.CancelWorkUpload)
+	router.HEAD(baseURL+"/works/uploads/:upload_id", wrapper.GetWorkUploadOffset)
+	router.PATCH(baseURL+"/works/uploads/:upload_id", wrapper.UploadWorkChunk)
+	router.POST(baseURL+"/works/uploads/:upload_id/complete", wrapper.CompleteWorkUpload)
+	router.PUT(baseURL+"/works/:work_id/current-revision", wrapper.SetCurrentRevision)
+	router.GET(baseURL+"/works/:work_id/diff", wrapper.DiffWorkRevisions)
 	router.GET(baseURL+"/works/:work_id/reports", wrapper.GetWorkReports)
+	router.GET(baseURL+"/works/:work_id/revisions", wrapper.ListWorkRevisions)
+	router.GET(baseURL+"/works/:work_id/revisions/:revision/download", wrapper.DownloadWorkRevision)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xYW2/bNhT+KwS3hxZQIjlOulRv6bZ2wS4Nkg4F1hnCsXRks6FIlaSceoH/+0BSV0vO",
-	"0sv6EEAReW7fd27yPU1lUUqBwmga31OFupRCo/vnBWTX+KFCbex/qRQGhXuEsuQsBcOkCN9rKew7na6x",
-	"APv0vcKcxvS7sFMd+lMdXpTsZ6WkorvdLqAZ6lSx0uqhsTVHVG1vF9BLYVAJ4DeoNqi81Ldwo7FLtDNM",
-	"0F8M6B/SvJSVyL6JF9eoZaVSJEIakjuz9lItajW30vE9LZUsURnmecvQAOPuEbKMWY3Ar3pXjKowoGZb",
-	"Io2pXL7H1CGOjToUVUHjd3QDnGUussSfBVRIk3h3AspqpNpDixhLMakEbIBxWHKki4DiRyhKbm1NKKy9",
-	"0EYxsbJeFKg1rND50Qr+zrRmYuXygynMSM6QZzHRpspQmIRlU6oMK1AbKMqhspPo5PRodnIUnb2ZRfE8",
-	"iqPoLxrQXKoCDI1pBgaPrOxY524CtmsspTJjGkAA32qmk6xSPubCvS+YYIUFOGqVWSRX6LIMtGYrUdRB",
-	"xffjqFKFYDBLwNl8jNc1tUkP2mG2uUQi9TFhOdEGTKUJ0yQHxnES3ZxxPOQj00nJYcVAMV2M7b1RlTPT",
-	"3SEZGkxN39JSSo4grLruXqJTqSYiuEKVojDWfTmplzyJjmZR9LRPdM4lGBrQAj56RmZRFEzxI6pi6elR",
-	"juxDYWtWMA4quZPqVo+d/I1pY92rrxF3jbTlZNAnyEM948aLvpXqlnbJCErB1jngePOWc6i4jdJq4eih",
-	"bSq7/65meDFBca+8psK9kypLUln5RvhwXttYp/VM1VQ/zFFh1fAxs03KlvZBiZ+fHZ99Gc/DyLve0bw/",
-	"PXs2VRO9KDsZ9/K2lAmH5Twp0Si5SWY/zOfz8+cnz6LHdRkLxU21LGwjlOK6ntNjcHpVOUy+P0suIXOt",
-	"kyNhNgqWM7Q9uHPVScMynZ3Mj8ssnwpRWydM14C+Sl8dILfnt2AfKnS1csjrIcBsA6IB+HkURY8C2L5i",
-	"Ipdj+xdXl+QVGLyDLcmlIhfCsF5/udlqg4U1wYxzZu+8Eb24uqQB3aDSXu3sODqObOCyRAElozGdH0fH",
-	"cxrQEszacRlaOnR4X3O6s+9W6FC3lLuhcpnRmP4k74Rl9yXj6BQoKNCg0jR+d0+ZtWeV2vkNRcuzG5rN",
-	"RG22gm512cdsEQzXw5MoemAVkqlBc6SNQiiGK1GbD0smQG0n2BltQzYu0hjaBfQ0Oj3UKFsXw3Zfc4tT",
-	"VRTWWIcVAVcJ1jysLFDOiqYLez1cI3CzPgj4L+74xzWmt/STYBlviBPl22wO/V3MO2TRqkTzvDg0lbWR",
-	"qumJnyi/8vn6OaLd8OlK8/Wvj62+IeM3fpW0G0hjckijZ4CkjgJHWTt0S+m/WPY2BCU/+gquO5jdJ8H3",
-	"FQva8d+CBns0u4Zr3BTylYLavJDZdo/gouKGlaBMaHP7KAMDD3E8WvH2Gk57fKjfta3OdtxDq9lYsasi",
-	"I0lV+vwX9g/49h/sd+lDVbk/E/f48meHPG5EZyfzyXzoutA7Otjph1jVkS0mE2jYyXZfWJYPLWEHZvFE",
-	"GtubpJ2Yfcgzoqs0Ra3zivOtb2rRfze13je5FZnND9IsCQe1Qnvt7DGap763hyXnq4EAEXhX141UpG1W",
-	"XSN96ypx0VVleF+P913oF2h9sLO+Qldv1/W1xwyzZnX4/4bZOD0etazXX4ajPX2cJ82XQY2OA9as/dbz",
-	"GQPvq1H+Cg0Bzgd++Z7Zo7vhauEC8z+beLL2gpQpcJLhBrksXX/zd+1gUZzGdG1MGYcht/fWUpv4PDqP",
-	"QihZuJlRS1ltcV/x6yaDNLljZt38KuC/r7o88Wm5CyYLRnY6npRuVBhJ6jlK6h82nna6/K4w1uWxmNbW",
-	"VMqEugbC3WL3bwAAAP//TUhA5JMTAAA=",
+	"H4sIAAAAAAAC/+x8aXMbt7L2X0HN+1Ydqe5w0eaTMJ8UWbZ1Ylu6knxcSehigTNNEtEMMAEwkhmX/vut",
+	"xjILB6QoW0c3Sd1vJGewNbqffrob4JcoEXkhOHCtotGXqKCS5qBBmm8fhbw5S/ET49EoKqheRHHEaQ7R",
+	"KLoT8mbC0iiOJPxeMglpNNKyhDhSyQJyis30ssBXlZaMz6P7+3t8WRWCKzAD/EjTS/i9BKXxWyK4Bm4+",
+	"0qLIWEI1E3zwmxIcf6u7/f8SZtEo+n+DevID+1QNjgt2KqWQdrQUVCJZgf1EIxyOSDfefRy9EnLK0hT4",
+	"swx+vQBSKpBkQRXhgtAkAaWIFkQvgKA0YzJjGRAhiYRCSDPHM65BcppdgbwFaTt/jtn6cYkyAxOwL8bR",
+	"e6FfiZKnzzKLS1CilAkQLjSZmWHv4whlwRL4wOktZRmdZvAskznnQMTM7FbOEimUnYYiTJGyMZf7OPrA",
+	"aakXQrI/4HkE9Y4pxfg8Jozf0oylqETwuUCrJFOgEiTR4gZ4hE1dhzhe1SfavhQFSM2saaagKcvMR5qm",
+	"DMeh2UXjFWvrzsLF9DdIjMKC7w54mUejXyMzH7PeiX0WR1zoid3NOGJO0aqHTqyTpkg/xRF8pnmBOx3q",
+	"cAVn4igHpejc6EXd0AmJeLwiMwZZOiJKlylwbcGs05VmOShN86Ld2f5w/7C3t98bHl3vDUcHw9Fw+EsU",
+	"RzMhc6qjUZRSDT1s2+3zPiC2EwlUw4ciEy1IbO8JVYrNee7m2prOTSEmGZ0eTHBeoWU4FZzYB82mTY38",
+	"gxWhxohLFvabDQspcPr9NY0U+wMm06X2+tQCQ6ERW9gflUnhEE35Ma5f4EJyxlmOmrRXDYEqMweDRo2t",
+	"a03N/763fxCUf+2yfo1a298WcWPlrfV8CuzgK5bByYLyOXQ3LmWzWVcIHzibMUgJPkUxUJKL1P6k4bP2",
+	"IukIFt+fqBtWFJB2e/24WKJ7MZ3eUUWmJcs02ZkyTuXS9BkTLQTJqJy7jznlS5KYuavd4P5LkU+wqRN0",
+	"54WMcVATmqbQfN7YKPuChFzcrnvF0IvOci6oXhDGFUvB6AmVyYLdGi9pvKXbnK72aapL1QQiO7s48pOI",
+	"Iy/uKI5KbtefRp8CnWmxYfUhc760DrxrwZxmS8XUJC2lhbDc/F5p+TCk5VWrXKTQFdEbcVeZkNlyA/Xo",
+	"e0ZkHKEqjSMyE5IUUigjuXGUiBRG43I4PEgyyuclnYP5BuNozPFd53jxPbKTiBxNQhFcM6pdTBjaDEpP",
+	"KkJ5SjKmQdJMEY4WnOHou/0xH0duw9wUfjm7GFwfXxJVTnOmFBPcNh9HeZlp1sM1uFebr4jZmCu4xRHM",
+	"MtWI4Derz+iA0V9SxHQFyGE1ZEvsd8yLjM4ZlUzlE5UIaV7WC2ASKVfauwM2X2hICcXO5zDmUdPXGDEV",
+	"S70QPKRkHUDuwq7B9XRCjTJs4x2cC500XFh7u43DJu4xYTNiVR0XNqMsg3Qdgq+bo3sWgGnENJTtLRgi",
+	"sTRqZskpDuyJq6G0bnssiZ0w9K5S6SiOmIZcBQd2P1Ap6RK/MzXJqHaub8VhyBKMVhj6JZQmEhLgmnjb",
+	"ILcgUVdW/IkbYipEBpS7QWqdWDMQm5H6HZKChkRDGuxuVb8CCAYSZ4qbJYL9kp1hb2843G26v1kmKAov",
+	"p5+d+xsO4xBM8DKfWpSw+7JukyXU9jQRAW90BZrcLYDXUMIUoaTZbkRY6uWraA6eOf1DEaAyYyDH3LbU",
+	"JKc6WYAiU1C6T87vuH/F2i+hEkjGFC6fcaJYzjIqJ6hNaszvGIK+mijIZhPTkwEJKm0kkIiSu4ar4h/z",
+	"MBVpdN9d+lumNK7LvWZ0WpGKoXr93UTOr2xTjJhDml17oxRmtMy0wRYEGatX7fkUwFMkqj3yewklElUh",
+	"K0WPEcYxdLRv+J+NMKSYS1AqHvOq8+YruJeZtrKvyHXsUIP0iAIgLfCxaOj8p5tVFEf1BKK4tQzbU9CF",
+	"tpla57Gz3u7eHG+w7x+IhF61Ogu1qLMc7vzrDzJI9AITo1AP+2Gf8tiWA9wynMJLR/7aTMDRLfy4lYI1",
+	"6GVAvwxBk27AML3S4oEXvm51OK/A6laijUf7Ix9tdB4yNXGMovG4gcftqKMTTnSXXZqY61EuOiSPJgB0",
+	"xNGCsoDPQTx12OORdz3GNohR0CMJyeYMI+oNhNrnMXA+iCN21AbLRqP6A2mRk/UGUN1yGEg7ozTx9h9q",
+	"i7GYXk6Kyp22Qr7vjvpH3+Y/H4olD49ehKbWMJu6jfmxiskL0FLcTvb+eXBw8N33+y+GW6oU7rPWkIZt",
+	"7KtN6JEmshIu+2FDMbDNX1yB8ijzQP6iMzWbs1KPossb1ypmMwUBPvkjLt9wSHaLUYMgMypD+YdAzuGx",
+	"CPOA67MAtD3yIsRcNpC8qxXbe5UWiocZeVJK6XKXXazZ7FG+Alof64ZQGFcVHl664sJGY1lJdFdxbM0s",
+	"pNLEz90HErVtm67oNNnbP+gX6WydSgZ45qkJWZUW0oOhG7KF6NvxzRY2BHauFQ08tGRHMxtEERv3yfFU",
+	"Aa9CPZ9fGHO3hGmpa2+hTBReZqmh6FPfaX8lorZd9/bo/vQgOUyP4MXsn/S7aUiKTeVarQvYJ37+Jgh1",
+	"obYNU5kKusmmVXoJOu18ktRuS3072b7fSzfVOnnSkk3ba7Bbyr3X+H44HG7lNXBhkJToKa9QVawa2hLA",
+	"cakX9bdXfjn/+njdiUDeXO0fvbB5JIKYDSkxMRkKe0413NEluYEl2fnXx+vJ1enJ5en1bp+cZJTlaoSS",
+	"JzszIcfcQZ/C4KJCwd2YSJEB2XE/xUQDTRaojpLQNGd8N8bqRWyCPtOPe0GR2oVgn3phg0iTPa2ekLOX",
+	"hj8tibjjfXLl5jDmruQmeLZ0OSBxx220F/uwlKdO+9UPpBq1Z7NNGrmSTY/Z5o3Z/GBn3n7XKr+xWQOc",
+	"RvD1Ni60Lmw1h/GZCMQ+F2fktZM2DnrMNWvkD66WSkOO3TFt9GfluW96fHEWNSKsaK8/7A+NcyyA04JF",
+	"o+igP+xjphy5nNGYgRHH4IvDzXv8bR5ypFdaAs1VDRAYithPSgtMqfXJJYYtMTmb9epP7wWH3jsf2o/5",
+	"2az3zqVje1eMJ0AWQFOz5RJIQZWClOiFFOV8EaO/TsUdR4hWJKGcTGHMMbzNMZ2yP3yxa3YyoYZ37hwM",
+	"D3ftXqBPMIlXrGtHL10fryzGN6vfvwaL3t6NPKbo/Wml6L0/HG6oCYpEg+4pI9V2bbDCH5vKD6BBpyyI",
+	"6yJ+oDhyEjWFvySBQtv9UO1xuhhzek3n3Z3/71Ig3F69Oe4hWCQLSG5UmXtMrofd1PVbqnS18QHQNF6Y",
+	"INiuJPbWd4rd7g9fPJeMXbXOOB4NRKJEm3MlO0bITp1329twYido92Hbbdi89IPhYTiRa/xyVWHaadug",
+	"kKRrgS5u20WsOBzureMklXYPWnVv0+jg4Ub1MQzT4vDhFtUZBGyw9yLED/ye2O3AlSuqmZoxW6I3/j/P",
+	"cYtrFCC0yhrTOUKAEZuKPuHrgwXQTC8aONiGkjfm8QkaQfQog+8eAggwV8+wmvUsO6GlKV75z5/Wpf6r",
+	"ElLCZFKyIJBTXSmu8/H/UMS9T6YS6A1mb13hrcq7NTKESSaUyQOiY0FFp9msZz6vnZfzEV+9Ltf+6Zbl",
+	"ndaYf1wYhl4Aj/2pIVthITOqtOVDR8MDUnLNMkJJIYX1QuZVosokAUjVmH+1hNx0v0Y4jfJnRS/Pf9qK",
+	"QXYQzp23IUwRP2STZ0ajXz81zclagvUH5sWBI1SDL1VQcj9wGZ3BF/fB/Ojr5EGmcSHF52VVAzLQ2gg8",
+	"sPzHlOCksJQDdCk5JoMx8dZD26OMQzrmb67fvTUv1Yw2EQVC4kzSuWWWCzZfZLYwGKINr0HbAq9J7W7D",
+	"G6qFP4o5xMG+aoE9MQ3BSvFgofPsASfTCRZQois78Od1GS3ov2Ip9KbLnklJNpZgTmOYUM3UnVqZyoZ/",
+	"sFrgPURVXSqE2qi/LvTExKsdpU+uvUoXVGLxbIkRtITChrRauDadKjjNMgduuSHKLjIveQqyziG7oNQy",
+	"7UY5FxfG501D8pG/n49q9oqysFG9DYJ8qx9cXdgKm6QClHG5d5TpymCrIbQgM8aZWvTH3GVEqkidTCER",
+	"eVXEkStxfp0LN1Ib8zE/tmKT8JutpU6XreCDVGvyISwzxxhMxdyECOYgBD/cOyCvzt6eTq7Pzydvjy9f",
+	"n8bkcO/I/fbzxenk/fn15Pjt2/OPpy+RLZ2cv78+fX9tn707u3p3fH3yZsx3GtSXoOXgBKpCr4mNKvTy",
+	"r5lpIFqiiOeUcaXH3IisDmltOooUImPJcjcmh8MhOX13cf3zBGdo6Nv7fx+/PXs5Ob48eXP271MjnI+4",
+	"YMrJWQp5ITTwZNn7CZaOi5rqrpYIfY0dYIoUIJEI4zabWFnwBPrkGAWZ0WWrAuy9XYWl5ldMDUiHwHoB",
+	"Y+5rErWSVA2quenepekf0mp+PK2UySWdKukpK6o++QmWTkkTUVhb0f6oLXZwA4VVQoq+GcfBVRYgmUgJ",
+	"nWlvJ7UEvOf+AQmhrY3WT2NcDBEcZ0J1KOPVqtaSHS5Ihf6Y+YAMqAI15qiIN7AMORib3ftowWbFu7RB",
+	"5SRjKLk5cGxvLN+kmW6g3qd6ZTvQn/cJJR8+nL3cNYcvo5ELR2r/sqIsrVgrp5/fAp8jD94/OjIFFf99",
+	"Lw67HKMfP4p0ueJtzHkfRLsB6lovpZpuosGd2sFKqqR6vC659uAJyZmrsoSOwGjhLdD4A1sdI8bYQ4C9",
+	"20zjPhhTho4LNCsGq4S2TDcs80lPPgaKPPf3q6Tj/hvDnU1p7jXp/QAJwTdJldNtuKqWObaj7wD0dOWt",
+	"3YGglotzR2M8HFIeLs52SFRVOjErOBwO1wmgZi6NOxLPyam+f55rBi9P312cX5++P/l58tPpz5Oz95OL",
+	"y/PXl6dXVyNCG8JsO5gxX/VoDJ06y7LmMZixXcrewbMsxaRYqoO1duSjZxzZcQ10RTTLxB2YSwA4uKQp",
+	"S7TqEA8zx/39/5WN9oxp1OYAbjfxEGupPGOjY46BIUiEvVol7PYebWNDoVs0K4EAdqsd7TTEfwU2POX/",
+	"aEh+g/APrGPYivi3mKltR5Stlqs+eYuK4w6fGUJjYJ6TZFHy6lTcBUqNtIcefKlqyPcG+WocbHER7GDM",
+	"L86vrjd0MPAHuvrkmBNzPUOWhaEWdsJM1an2itDaOntNtadL8ub0+OWGcUK8x96CQBHbxG+0iUB8vaKG",
+	"Llts5dj2nmwK7ZMSAYP50NIOX9xs+6+3wo79UOLY9tU7r85CBBLea09//MkdlUX3h6D4aJuOA/fKVkBC",
+	"I9ezZ2JzfO5NokoXbAMUTSOwc0dr62aUTyhPIFsxhpY6Hq4tmCSmbQbpc27Fo1P3w++7CzDXJD3QkClg",
+	"kqQ+YvpkW2mFi8St9MJd3Thrat19eQ263hRnU2EKHNwaz07apuxM9NSef9rSoH3otfXtqsfYfQA2tjtC",
+	"9XhweU713Iiy1TXTxv3Jhs4FUMY7c3drkZRrNe411FmcWgW6SvdwMrmCj8fmfwt//LSt0FYIOIMTZBoP",
+	"pRzemxOTqFnmEByhmQSaLmtN2DGsAN3/2hxDW7U2LWP9hcDAEe3NGYdWCdiM/F/fWAl+iC8ENM6I2Fz+",
+	"Ljr+/O/sp5/EObQEVOeZ7Q0RG6A7HTSqGROXe97oUIIUwm4TfDaJQNNHCklGq4N7CK5P540cDFFL9b+e",
+	"TlTcvRmMrLAK90aLV/wHMeevkxr6axqDj/n9zpMlaKP3lAu9AFll6F2VNANTd8LUgOWI+I3pZ+fSr0wJ",
+	"KESm6wC2Ptu5dTD+xZW67gfu3HKveYq0KAM85h01AXedwvMtCLWW73oigpuY2AXTYkasgN2dNXcDVfkS",
+	"TtXMd2cPHtpaGcf73d2y15j7Rra4ESoNgD6x71RnwDv2G9qa+pWB+1uX7Z3lQ0dUmiLedNtqJf1cNfsz",
+	"ZJkraa756xbMC63uaPT3crlPY9jv6A0QWsmoUUp34tvSfjeeBcEbm4qYG/0xcRf64/pwG0JIdaPflQun",
+	"oO8AONF3Ysz97FTsUoxYN6v/jAHhpvtfDCp4hJTNZk39UV9tjv60x+8lyGXteZFMb3S6my0u3KkWX9/l",
+	"f9Kpt+5MBizR3oBsbKbhfF7y/2eOgUSHdUuo9k1PtBXDrI3RneBae/rR5UT8eZhtGOXX/H/Yt6relnei",
+	"/J9vtcuyXW3098addKqjLnfuFvifV7OepGaCGQ08f9RcPt3iiFRLr7ztPuLUn8+5+Jsfxrk0EN3+h4S9",
+	"x9XvADZu2tMA9qcnPefbEsVWitqmLR113eJk52UTEP4amvsUmGjs9lvA0LUcfPEf7wf+OspaRb6sz0M1",
+	"63Msa5wusJ2Zv1MRHNyfNZko444pwELoL2cX1V8gWeaSubOARLR72XTfpaU538pXVk+6Vt0+C794+vsy",
+	"9l+KKik3i+V/a6toXIPokveQabTPgLdvGf76CffR/nlkKImMpcuMpHALmSjMsS37bhRHpczc3bjRYJDh",
+	"ewuh9Oi74XfDgQFdN5HVLs+9qttCtz8saqavagW107+PV5u/cqf9fR87hfE8Wqzm+HfrvrBNqC/r9MK9",
+	"VdX4bnfeV95/uv+fAQBoN4PMmVUAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
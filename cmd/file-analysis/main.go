@@ -13,8 +13,11 @@ import (
 	"sd_hw3/internal/file-analysis/handlers"
 	"sd_hw3/internal/file-analysis/repository"
 	"sd_hw3/internal/file-analysis/service"
+	"sd_hw3/pkg/apiclient"
 	"sd_hw3/pkg/config"
 	"sd_hw3/pkg/db"
+	"sd_hw3/pkg/resilience"
+	"sd_hw3/pkg/svcauth"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,6 +26,9 @@ import (
 
 func main() {
 	cfg := config.Load()
+	if cfg.ServiceSecret == "" {
+		log.Fatal("SERVICE_SECRET is required")
+	}
 
 	// Подключение к БД
 	log.Println("Connecting to database...")
@@ -43,8 +49,36 @@ func main() {
 	}
 
 	repo := repository.NewReportRepository()
-	svc := service.NewAnalysisService(*cfg, repo)
-	h := handlers.NewHandler(svc)
+	fingerprintRepo := repository.NewFingerprintRepository()
+	extractedTextRepo := repository.NewExtractedTextRepository()
+	jobRepo := repository.NewJobRepository()
+	policies := service.NewPolicyService(*cfg, repository.NewPolicyRepository())
+	// Запросы к file-storage подписываются общим ключом
+	fileStorageClient, err := apiclient.NewFileStorage(cfg.FileStorageURL, apiclient.Options{
+		Service:         "file-analysis",
+		ServiceKey:      []byte(cfg.ServiceSecret),
+		Timeout:         cfg.ServiceTimeout,
+		TransferTimeout: cfg.ServiceTransferTimeout,
+		Retry: resilience.RetryPolicy{
+			MaxAttempts: cfg.ServiceRetryAttempts,
+			BaseDelay:   cfg.ServiceRetryBaseDelay,
+			MaxDelay:    cfg.ServiceRetryMaxDelay,
+		},
+		Breaker: resilience.BreakerConfig{
+			FailureThreshold: cfg.ServiceBreakerThreshold,
+			OpenTimeout:      cfg.ServiceBreakerOpenTimeout,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	svc := service.NewAnalysisService(*cfg, repo, fingerprintRepo, extractedTextRepo, jobRepo, policies, fileStorageClient)
+	h := handlers.NewHandler(svc, policies)
+
+	// Обработчики очереди анализа
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workers := service.NewWorkerPool(*cfg, svc, jobRepo)
+	workers.Start(workersCtx)
 
 	e := echo.New()
 	e.HideBanner = true
@@ -60,6 +94,11 @@ func main() {
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
 	}))
 
+	// Все маршруты, кроме /health, принимают только подписанные запросы gateway
+	e.Use(svcauth.Middleware([]byte(cfg.ServiceSecret), []string{"gateway"}, "/health"))
+	// Запрос gateway ограничен его оставшимся временем
+	e.Use(resilience.Deadline())
+
 	// Routes
 	fileanalysis.RegisterHandlers(e, h)
 
@@ -76,6 +115,8 @@ func main() {
 			"status":  "OK",
 			"service": "file-analysis",
 			"version": "1.0.0",
+			// Состояние выключателя запросов к file-storage: closed, open или half-open
+			"file_storage_circuit": fileStorageClient.BreakerState().String(),
 		})
 	})
 
@@ -99,4 +140,17 @@ func main() {
 	if err := e.Shutdown(ctx); err != nil {
 		e.Logger.Errorf("error during shutdown: %v", err)
 	}
+
+	// Незавершенные задания останутся в очереди и будут взяты после перезапуска
+	stopWorkers()
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Println("Analysis workers did not stop in time")
+	}
 }
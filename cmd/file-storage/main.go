@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"sd_hw3/internal/file-storage/service"
 	"sd_hw3/pkg/config"
 	"sd_hw3/pkg/db"
+	"sd_hw3/pkg/resilience"
+	"sd_hw3/pkg/svcauth"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,6 +26,9 @@ import (
 func main() {
 	// Загрузка конфигурации
 	cfg := loadConfig()
+	if cfg.ServiceSecret == "" {
+		log.Fatal("SERVICE_SECRET is required")
+	}
 
 	// Подключение к БД
 	log.Println("Connecting to database...")
@@ -44,14 +50,34 @@ func main() {
 
 	// Инициализация сервисов
 	storageService, err := service.NewStorageService(config.Config{
-		UploadDir:     cfg.UploadDir,
-		MaxUploadSize: cfg.MaxUploadSize,
-		AllowedTypes:  cfg.AllowedTypes,
+		UploadDir:         cfg.UploadDir,
+		MaxUploadSize:     cfg.MaxUploadSize,
+		AllowedTypes:      cfg.AllowedTypes,
+		StorageBackend:    cfg.StorageBackend,
+		S3Endpoint:        cfg.S3Endpoint,
+		S3Region:          cfg.S3Region,
+		S3Bucket:          cfg.S3Bucket,
+		S3AccessKeyID:     cfg.S3AccessKeyID,
+		S3SecretAccessKey: cfg.S3SecretAccessKey,
+		S3PathStyle:       cfg.S3PathStyle,
+
+		MaxResumableUploadSize: cfg.MaxResumableUploadSize,
+		UploadSessionTTL:       cfg.UploadSessionTTL,
+		UploadClaimTimeout:     cfg.UploadClaimTimeout,
+		IdempotencyKeyTTL:      cfg.IdempotencyKeyTTL,
+		IdempotencyLockTimeout: cfg.IdempotencyLockTimeout,
+		ArchiveMaxMembers:      cfg.ArchiveMaxMembers,
+		ArchiveMaxUnpackedSize: cfg.ArchiveMaxUnpackedSize,
 	})
 	if err != nil {
 		log.Fatalf("Failed to create storage service: %v", err)
 	}
 
+	// Очистка истекших загрузок частями и ключей идемпотентности
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	defer stopCleanup()
+	storageService.StartUploadCleanup(cleanupCtx, cfg.UploadCleanupInterval)
+
 	// Инициализация Echo
 	e := echo.New()
 	e.HideBanner = true
@@ -62,14 +88,21 @@ func main() {
 	}))
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowOrigins:  []string{"*"},
+		AllowMethods:  []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "Upload-Offset"},
+		ExposeHeaders: []string{echo.HeaderLocation, "Upload-Offset", "Upload-Length", "Upload-Expires"},
 	}))
 
-	// Лимит размера файла
+	// Лимит размера файла (и одной части при загрузке частями); действует
+	// раньше проверки подписи, которая может читать тело
 	e.Use(middleware.BodyLimit(fmt.Sprintf("%dM", cfg.MaxUploadSize/(1024*1024))))
 
+	// Все маршруты, кроме /health, принимают только подписанные запросы сервисов
+	e.Use(svcauth.Middleware([]byte(cfg.ServiceSecret), []string{"gateway", "file-analysis"}, "/health"))
+	// Запрос сервиса ограничен его оставшимся временем
+	e.Use(resilience.Deadline())
+
 	// Создание обработчика
 	fileHandler := handler.NewHandler(storageService)
 
@@ -101,6 +134,7 @@ func main() {
 	// Graceful shutdown
 	go func() {
 		log.Printf("Starting server on %s", serverAddr)
+		log.Printf("Storage backend: %s", storageService.BackendName())
 		log.Printf("Storage path: %s", cfg.UploadDir)
 		log.Printf("Max file size: %d bytes", cfg.MaxUploadSize)
 
@@ -134,6 +168,27 @@ func loadConfig() config.Config {
 		AllowedTypes:  parseEnvStringSlice("ALLOWED_TYPES", []string{}),
 		RunMigrations: parseEnvBool("RUN_MIGRATIONS", true),
 		MigrationsDir: getEnv("MIGRATIONS_DIR", "./migrations"),
+		// Хранилище содержимого файлов: local или s3
+		StorageBackend:    getEnv("STORAGE_BACKEND", "local"),
+		S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+		S3PathStyle:       parseEnvBool("S3_PATH_STYLE", true),
+		// Загрузка частями
+		MaxResumableUploadSize: parseEnvInt64("MAX_RESUMABLE_UPLOAD_SIZE", 500*1024*1024), // 500MB
+		UploadSessionTTL:       parseEnvDuration("UPLOAD_SESSION_TTL", 24*time.Hour),
+		UploadClaimTimeout:     parseEnvDuration("UPLOAD_CLAIM_TIMEOUT", 10*time.Minute),
+		UploadCleanupInterval:  parseEnvDuration("UPLOAD_CLEANUP_INTERVAL", 10*time.Minute),
+		// Ключи идемпотентности отправки работ через gateway
+		IdempotencyKeyTTL:      parseEnvDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+		IdempotencyLockTimeout: parseEnvDuration("IDEMPOTENCY_LOCK_TIMEOUT", 10*time.Minute),
+		// Ограничения распаковки архивов
+		ArchiveMaxMembers:      int(parseEnvInt64("ARCHIVE_MAX_MEMBERS", 1000)),
+		ArchiveMaxUnpackedSize: parseEnvInt64("ARCHIVE_MAX_UNPACKED_SIZE", 100*1024*1024), // 100MB
+		// Ключ подписи запросов от gateway и file-analysis
+		ServiceSecret: getEnv("SERVICE_SECRET", ""),
 	}
 }
 
@@ -154,6 +209,15 @@ func parseEnvInt64(key string, defaultValue int64) int64 {
 	return defaultValue
 }
 
+func parseEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
 func parseEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		return value == "true" || value == "1"
@@ -163,9 +227,13 @@ func parseEnvBool(key string, defaultValue bool) bool {
 
 func parseEnvStringSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
-		// Простая реализация - разделение запятыми
+		// Значения через запятую, пустые пропускаются
 		var result []string
-		// Здесь должна быть логика парсинга массива строк
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
 		return result
 	}
 	return defaultValue
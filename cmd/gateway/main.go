@@ -11,9 +11,12 @@ import (
 	"time"
 
 	"sd_hw3/api/generated/gateway"
+	"sd_hw3/internal/gateway/auth"
 	"sd_hw3/internal/gateway/handlers"
 	"sd_hw3/internal/gateway/service"
+	"sd_hw3/pkg/apiclient"
 	"sd_hw3/pkg/config"
+	"sd_hw3/pkg/resilience"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,13 +26,46 @@ func main() {
 	// Загрузка конфигурации
 	cfg := config.Load()
 
-	// Создание клиентов для микросервисов
-	fileStorageService := service.NewFileStorageService(cfg.FileStorageURL)
-	fileAnalysisService := service.NewFileAnalysisService(cfg.FileAnalysisURL)
+	// Создание клиентов для микросервисов; запросы к ним подписываются общим ключом
+	if cfg.ServiceSecret == "" {
+		log.Fatal("SERVICE_SECRET is required")
+	}
+	clientOptions := apiclient.Options{
+		Service:         "gateway",
+		ServiceKey:      []byte(cfg.ServiceSecret),
+		Timeout:         cfg.ServiceTimeout,
+		TransferTimeout: cfg.ServiceTransferTimeout,
+		Retry: resilience.RetryPolicy{
+			MaxAttempts: cfg.ServiceRetryAttempts,
+			BaseDelay:   cfg.ServiceRetryBaseDelay,
+			MaxDelay:    cfg.ServiceRetryMaxDelay,
+		},
+		Breaker: resilience.BreakerConfig{
+			FailureThreshold: cfg.ServiceBreakerThreshold,
+			OpenTimeout:      cfg.ServiceBreakerOpenTimeout,
+		},
+	}
+	fileStorageClient, err := apiclient.NewFileStorage(cfg.FileStorageURL, clientOptions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fileAnalysisClient, err := apiclient.NewFileAnalysis(cfg.FileAnalysisURL, clientOptions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fileStorageService := service.NewFileStorageService(fileStorageClient)
+	fileAnalysisService := service.NewFileAnalysisService(fileAnalysisClient)
 
 	// Создание обработчика
 	handler := handlers.NewHandler(fileStorageService, fileAnalysisService)
 
+	// Без ключа подписи токенов gateway не запускается: иначе все маршруты
+	// оказались бы открыты
+	if cfg.JWTSecret == "" {
+		log.Fatal("JWT_SECRET is required")
+	}
+	verifier := auth.NewVerifier([]byte(cfg.JWTSecret))
+
 	// Создание Echo роутера
 	e := echo.New()
 	e.HideBanner = true
@@ -37,23 +73,20 @@ func main() {
 	// Middleware
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
-	}))
+	if len(cfg.CORSAllowedOrigins) > 0 {
+		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+			AllowOrigins:  cfg.CORSAllowedOrigins,
+			AllowMethods:  []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
+			AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "Upload-Offset", "Idempotency-Key"},
+			ExposeHeaders: []string{echo.HeaderLocation, echo.HeaderWWWAuthenticate, "Upload-Offset", "Upload-Length", "Upload-Expires", "Idempotent-Replayed"},
+		}))
+	}
+	e.Use(handlers.Authenticate(verifier, "/health"))
 
 	// Регистрация маршрутов
+	// /health обслуживает handler.HealthCheck: он показывает и состояние сервисов
 	gateway.RegisterHandlers(e, handler)
 
-	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(http.StatusOK, map[string]string{
-			"status":  "OK",
-			"service": "api gateway",
-			"version": "1.0.0",
-		})
-	})
-
 	// Запуск сервера
 	port := cfg.ServerPort
 	serverAddr := fmt.Sprintf(":%s", port)
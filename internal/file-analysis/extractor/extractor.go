@@ -0,0 +1,88 @@
+package extractor
+
+import (
+	"mime"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// Extractor извлекает простой текст из файла определенного формата
+type Extractor interface {
+	// Name название формата, сохраняется вместе с извлеченным текстом
+	Name() string
+	Extract(content []byte) (string, error)
+}
+
+// Registry выбирает extractor по content type файла, а если тип не указан
+// или неизвестен (например, application/octet-stream) - по расширению
+type Registry struct {
+	byContentType map[string]Extractor
+	byExtension   map[string]Extractor
+	fallback      Extractor
+}
+
+// NewRegistry создает реестр со всеми поддерживаемыми форматами
+func NewRegistry() *Registry {
+	r := &Registry{
+		byContentType: make(map[string]Extractor),
+		byExtension:   make(map[string]Extractor),
+		fallback:      plainText{},
+	}
+
+	r.Register(pdfExtractor{}, []string{"application/pdf", "application/x-pdf"}, ".pdf")
+	r.Register(docxExtractor{},
+		[]string{"application/vnd.openxmlformats-officedocument.wordprocessingml.document"}, ".docx")
+	r.Register(odtExtractor{}, []string{"application/vnd.oasis.opendocument.text"}, ".odt")
+	r.Register(rtfExtractor{}, []string{"application/rtf", "text/rtf", "application/x-rtf"}, ".rtf")
+	r.Register(htmlExtractor{}, []string{"text/html", "application/xhtml+xml"}, ".html", ".htm", ".xhtml")
+	r.Register(markdownExtractor{}, []string{"text/markdown", "text/x-markdown"}, ".md", ".markdown")
+
+	return r
+}
+
+// Register регистрирует extractor для перечисленных content type и расширений
+func (r *Registry) Register(e Extractor, contentTypes []string, extensions ...string) {
+	for _, ct := range contentTypes {
+		r.byContentType[ct] = e
+	}
+	for _, ext := range extensions {
+		r.byExtension[ext] = e
+	}
+}
+
+// For возвращает extractor для файла; для неизвестных форматов файл считается простым текстом
+func (r *Registry) For(filename, contentType string) Extractor {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if e, ok := r.byContentType[strings.ToLower(mediaType)]; ok {
+			return e
+		}
+	}
+	if e, ok := r.byExtension[strings.ToLower(filepath.Ext(filename))]; ok {
+		return e
+	}
+	return r.fallback
+}
+
+// PlainTextName название extractor, используемого для файлов, не требующих разбора
+const PlainTextName = "text"
+
+type plainText struct{}
+
+func (plainText) Name() string {
+	return PlainTextName
+}
+
+func (plainText) Extract(content []byte) (string, error) {
+	return toValidUTF8(content), nil
+}
+
+// toValidUTF8 заменяет некорректные UTF-8 последовательности и удаляет нулевые
+// байты, чтобы текст можно было сохранить в БД
+func toValidUTF8(content []byte) string {
+	text := string(content)
+	if !utf8.Valid(content) {
+		text = strings.ToValidUTF8(text, "�")
+	}
+	return strings.ReplaceAll(text, "\x00", "")
+}
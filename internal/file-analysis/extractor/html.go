@@ -0,0 +1,142 @@
+package extractor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// htmlExtractor извлекает видимый текст HTML-документа
+type htmlExtractor struct{}
+
+func (htmlExtractor) Name() string {
+	return "html"
+}
+
+func (htmlExtractor) Extract(content []byte) (string, error) {
+	return htmlToText(content)
+}
+
+// skippedElements элементы, содержимое которых не является текстом работы
+var skippedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Head:     true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+}
+
+// blockElements элементы, после которых начинается новая строка
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Br: true, atom.Li: true, atom.Tr: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Pre: true, atom.Blockquote: true, atom.Section: true, atom.Article: true,
+	atom.Table: true, atom.Ul: true, atom.Ol: true, atom.Hr: true,
+}
+
+func htmlToText(content []byte) (string, error) {
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+	var b strings.Builder
+	skip := 0
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+				return "", err
+			}
+			return strings.TrimSpace(b.String()), nil
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			a := atom.Lookup(name)
+			if skippedElements[a] {
+				skip++
+			}
+			if blockElements[a] {
+				b.WriteByte('\n')
+			}
+		case html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if blockElements[atom.Lookup(name)] {
+				b.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			a := atom.Lookup(name)
+			if skippedElements[a] && skip > 0 {
+				skip--
+			}
+			if blockElements[a] {
+				b.WriteByte('\n')
+			}
+		case html.TextToken:
+			if skip == 0 {
+				b.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+// markdownExtractor убирает разметку Markdown, оставляя текст
+type markdownExtractor struct{}
+
+func (markdownExtractor) Name() string {
+	return "markdown"
+}
+
+var (
+	mdImage    = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLink     = regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`)
+	mdRefLink  = regexp.MustCompile(`\[([^\]]+)\]\[[^\]]*\]`)
+	mdLinkDef  = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s+\S+.*$`)
+	mdHeading  = regexp.MustCompile(`^\s{0,3}#{1,6}\s+`)
+	mdQuote    = regexp.MustCompile(`^\s{0,3}(>\s?)+`)
+	mdList     = regexp.MustCompile(`^\s*([-*+]|\d+[.)])\s+`)
+	mdRule     = regexp.MustCompile(`^\s{0,3}([-*_]\s*){3,}$`)
+	mdEmphasis = regexp.MustCompile("(\\*{1,3}|~~|`+)")
+	mdHTMLTag  = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+)
+
+func (markdownExtractor) Extract(content []byte) (string, error) {
+	lines := strings.Split(toValidUTF8(content), "\n")
+	var b strings.Builder
+	inFence := false
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			// Содержимое блоков кода сохраняется как есть
+			b.WriteString(line)
+			b.WriteByte('\n')
+			continue
+		}
+		if mdRule.MatchString(line) || mdLinkDef.MatchString(line) {
+			b.WriteByte('\n')
+			continue
+		}
+
+		line = mdHeading.ReplaceAllString(line, "")
+		line = mdQuote.ReplaceAllString(line, "")
+		line = mdList.ReplaceAllString(line, "")
+		line = mdImage.ReplaceAllString(line, "$1")
+		line = mdLink.ReplaceAllString(line, "$1")
+		line = mdRefLink.ReplaceAllString(line, "$1")
+		line = mdHTMLTag.ReplaceAllString(line, "")
+		line = mdEmphasis.ReplaceAllString(line, "")
+
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,137 @@
+package extractor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxXMLPartSize ограничение на размер распакованной XML-части документа
+const maxXMLPartSize = 64 << 20
+
+// docxExtractor извлекает текст из word/document.xml документа Office Open XML
+type docxExtractor struct{}
+
+func (docxExtractor) Name() string {
+	return "docx"
+}
+
+func (docxExtractor) Extract(content []byte) (string, error) {
+	return extractZippedXML(content, "word/document.xml", func(el xml.StartElement, end bool) string {
+		if el.Name.Space != "" && !strings.Contains(el.Name.Space, "wordprocessingml") {
+			return ""
+		}
+		switch {
+		case !end && el.Name.Local == "tab":
+			return "\t"
+		case !end && (el.Name.Local == "br" || el.Name.Local == "cr"):
+			return "\n"
+		case end && el.Name.Local == "p":
+			return "\n"
+		}
+		return ""
+	}, func(el xml.StartElement) bool {
+		return el.Name.Local == "t" || el.Name.Local == "delText"
+	})
+}
+
+// odtExtractor извлекает текст из content.xml документа OpenDocument
+type odtExtractor struct{}
+
+func (odtExtractor) Name() string {
+	return "odt"
+}
+
+func (odtExtractor) Extract(content []byte) (string, error) {
+	return extractZippedXML(content, "content.xml", func(el xml.StartElement, end bool) string {
+		if !strings.Contains(el.Name.Space, "opendocument:xmlns:text") {
+			return ""
+		}
+		switch {
+		case !end && el.Name.Local == "tab":
+			return "\t"
+		case !end && el.Name.Local == "line-break":
+			return "\n"
+		case !end && el.Name.Local == "s":
+			return strings.Repeat(" ", max(spaceCount(el), 1))
+		case end && (el.Name.Local == "p" || el.Name.Local == "h"):
+			return "\n"
+		}
+		return ""
+	}, nil)
+}
+
+func spaceCount(el xml.StartElement) int {
+	for _, attr := range el.Attr {
+		if attr.Name.Local == "c" {
+			var n int
+			fmt.Sscanf(attr.Value, "%d", &n)
+			return min(n, 1000)
+		}
+	}
+	return 1
+}
+
+// extractZippedXML читает XML-файл part из zip-архива и собирает его текстовое
+// содержимое. separator возвращает текст, который нужно вставить на месте
+// открывающего или закрывающего тега. Если textElement задан, учитывается
+// только текст внутри подходящих элементов.
+func extractZippedXML(content []byte, part string,
+	separator func(el xml.StartElement, end bool) string,
+	textElement func(el xml.StartElement) bool,
+) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", fmt.Errorf("invalid document archive: %w", err)
+	}
+
+	file, err := archive.Open(part)
+	if err != nil {
+		return "", fmt.Errorf("document part %s not found: %w", part, err)
+	}
+	defer file.Close()
+
+	decoder := xml.NewDecoder(io.LimitReader(file, maxXMLPartSize))
+	var b strings.Builder
+	var stack []xml.StartElement
+	inText := 0
+
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse %s: %w", part, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t)
+			if textElement != nil && textElement(t) {
+				inText++
+			}
+			b.WriteString(separator(t, false))
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+			start := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if textElement != nil && textElement(start) {
+				inText--
+			}
+			b.WriteString(separator(start, true))
+		case xml.CharData:
+			if textElement == nil || inText > 0 {
+				b.Write(t)
+			}
+		}
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
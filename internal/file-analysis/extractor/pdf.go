@@ -0,0 +1,814 @@
+package extractor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// pdfExtractor извлекает текст из PDF. Поддерживаются потоки без сжатия и со
+// сжатием FlateDecode/ASCIIHex/ASCII85, потоки объектов (PDF 1.5+), таблицы
+// ToUnicode шрифтов и текст внутри form XObject. Зашифрованные документы
+// и отсканированные изображения не поддерживаются.
+type pdfExtractor struct{}
+
+func (pdfExtractor) Name() string {
+	return "pdf"
+}
+
+const (
+	// maxPDFStreamSize ограничение на размер распакованного потока
+	maxPDFStreamSize = 64 << 20
+	// maxPDFDepth ограничение вложенности дерева страниц и form XObject
+	maxPDFDepth = 32
+)
+
+func (pdfExtractor) Extract(content []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(content, "\x00\t\n\r "), []byte("%PDF-")) {
+		return "", errors.New("not a PDF document")
+	}
+
+	doc := parsePDF(content)
+	if doc.encrypted {
+		return "", errors.New("encrypted PDF documents are not supported")
+	}
+
+	var b strings.Builder
+	for _, page := range doc.pages() {
+		doc.renderPage(&b, page)
+		b.WriteByte('\n')
+	}
+
+	return strings.TrimSpace(sanitizeText(b.String())), nil
+}
+
+// Значения PDF-объектов
+type (
+	pdfName     string
+	pdfKeyword  string
+	pdfString   []byte
+	pdfArray    []any
+	pdfDict     map[pdfName]any
+	pdfRef      int
+	pdfEndArray struct{}
+	pdfEndDict  struct{}
+)
+
+type pdfObject struct {
+	value  any
+	stream []byte // нераспакованные данные потока, если объект является потоком
+}
+
+type pdfDocument struct {
+	objects   map[int]*pdfObject
+	root      pdfDict
+	encrypted bool
+	cmaps     map[int]*toUnicode
+}
+
+var (
+	objHeader = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	rootRef   = regexp.MustCompile(`/Root\s+(\d+)\s+\d+\s+R`)
+)
+
+func parsePDF(content []byte) *pdfDocument {
+	doc := &pdfDocument{objects: make(map[int]*pdfObject), cmaps: make(map[int]*toUnicode)}
+
+	// Объекты читаются по порядку; более поздние версии (инкрементальные
+	// обновления) перезаписывают ранние
+	for _, m := range objHeader.FindAllSubmatchIndex(content, -1) {
+		num, err := strconv.Atoi(string(content[m[2]:m[3]]))
+		if err != nil {
+			continue
+		}
+		lx := &pdfLexer{data: content, pos: m[1]}
+		value := lx.value()
+		obj := &pdfObject{value: value}
+		if dict, ok := value.(pdfDict); ok {
+			if kw, ok := lx.next().(pdfKeyword); ok && kw == "stream" {
+				obj.stream = readStreamData(content, lx.pos, dict)
+			}
+		}
+		doc.objects[num] = obj
+	}
+
+	for _, obj := range doc.objects {
+		if dict, ok := obj.value.(pdfDict); ok && dict["Type"] == pdfName("ObjStm") {
+			doc.expandObjectStream(dict, obj)
+		}
+	}
+
+	if matches := rootRef.FindAllSubmatch(content, -1); len(matches) > 0 {
+		num, _ := strconv.Atoi(string(matches[len(matches)-1][1]))
+		doc.root, _ = doc.resolve(pdfRef(num)).(pdfDict)
+	}
+	doc.encrypted = bytes.Contains(content, []byte("/Encrypt"))
+
+	return doc
+}
+
+// readStreamData возвращает данные потока, начинающиеся после ключевого слова stream
+func readStreamData(content []byte, pos int, dict pdfDict) []byte {
+	if bytes.HasPrefix(content[pos:], []byte("\r\n")) {
+		pos += 2
+	} else if pos < len(content) && (content[pos] == '\n' || content[pos] == '\r') {
+		pos++
+	}
+
+	if length, ok := dict["Length"].(float64); ok {
+		end := pos + int(length)
+		if length >= 0 && end <= len(content) {
+			rest := bytes.TrimLeft(content[end:], "\r\n ")
+			if bytes.HasPrefix(rest, []byte("endstream")) {
+				return content[pos:end]
+			}
+		}
+	}
+
+	end := bytes.Index(content[pos:], []byte("endstream"))
+	if end < 0 {
+		return content[pos:]
+	}
+	return bytes.TrimRight(content[pos:pos+end], "\r\n")
+}
+
+func (d *pdfDocument) expandObjectStream(dict pdfDict, obj *pdfObject) {
+	data, err := d.decodeStream(dict, obj.stream)
+	if err != nil {
+		return
+	}
+	n, _ := dict["N"].(float64)
+	first, _ := dict["First"].(float64)
+	if int(first) > len(data) {
+		return
+	}
+
+	header := &pdfLexer{data: data[:int(first)]}
+	for i := 0; i < int(n); i++ {
+		num, ok1 := header.next().(float64)
+		offset, ok2 := header.next().(float64)
+		if !ok1 || !ok2 {
+			return
+		}
+		start := int(first) + int(offset)
+		if start >= len(data) {
+			continue
+		}
+		// Объекты из обычного тела файла имеют приоритет над сжатыми
+		if _, exists := d.objects[int(num)]; exists {
+			continue
+		}
+		lx := &pdfLexer{data: data, pos: start}
+		d.objects[int(num)] = &pdfObject{value: lx.value()}
+	}
+}
+
+// resolve разыменовывает косвенную ссылку
+func (d *pdfDocument) resolve(v any) any {
+	for i := 0; i < maxPDFDepth; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		obj, ok := d.objects[int(ref)]
+		if !ok {
+			return nil
+		}
+		v = obj.value
+	}
+	return nil
+}
+
+func (d *pdfDocument) dict(v any) pdfDict {
+	dict, _ := d.resolve(v).(pdfDict)
+	return dict
+}
+
+// streamOf возвращает распакованные данные потока, на который ссылается v
+func (d *pdfDocument) streamOf(v any) ([]byte, pdfDict) {
+	ref, ok := v.(pdfRef)
+	if !ok {
+		return nil, nil
+	}
+	obj, ok := d.objects[int(ref)]
+	if !ok || obj.stream == nil {
+		return nil, nil
+	}
+	dict, _ := obj.value.(pdfDict)
+	data, err := d.decodeStream(dict, obj.stream)
+	if err != nil {
+		return nil, nil
+	}
+	return data, dict
+}
+
+func (d *pdfDocument) decodeStream(dict pdfDict, data []byte) ([]byte, error) {
+	var filters []any
+	switch f := d.resolve(dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{f}
+	case pdfArray:
+		filters = f
+	}
+
+	for _, f := range filters {
+		var err error
+		switch d.resolve(f) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			var r io.ReadCloser
+			r, err = zlib.NewReader(bytes.NewReader(data))
+			if err == nil {
+				data, err = io.ReadAll(io.LimitReader(r, maxPDFStreamSize))
+				r.Close()
+				// Поврежденный хвост потока не мешает прочитать основную часть
+				if len(data) > 0 && errors.Is(err, io.ErrUnexpectedEOF) {
+					err = nil
+				}
+			}
+		case pdfName("ASCIIHexDecode"), pdfName("AHx"):
+			data, err = decodeHex(data)
+		case pdfName("ASCII85Decode"), pdfName("A85"):
+			data, err = decodeASCII85(data)
+		default:
+			return nil, fmt.Errorf("unsupported filter %v", f)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func decodeHex(data []byte) ([]byte, error) {
+	if end := bytes.IndexByte(data, '>'); end >= 0 {
+		data = data[:end]
+	}
+	clean := bytes.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, data)
+	if len(clean)%2 == 1 {
+		clean = append(clean, '0')
+	}
+	return hex.DecodeString(string(clean))
+}
+
+func decodeASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(bytes.TrimSpace(data), []byte("<~"))
+	if end := bytes.Index(data, []byte("~>")); end >= 0 {
+		data = data[:end]
+	}
+	out := make([]byte, len(data))
+	n, _, err := ascii85.Decode(out, data, true)
+	return out[:n], err
+}
+
+// pages возвращает словари страниц в порядке следования в документе
+func (d *pdfDocument) pages() []pdfDict {
+	var pages []pdfDict
+	var walk func(node pdfDict, depth int)
+	walk = func(node pdfDict, depth int) {
+		if node == nil || depth > maxPDFDepth {
+			return
+		}
+		if node["Type"] == pdfName("Page") || (node["Kids"] == nil && node["Contents"] != nil) {
+			pages = append(pages, node)
+			return
+		}
+		kids, _ := d.resolve(node["Kids"]).(pdfArray)
+		for _, kid := range kids {
+			walk(d.dict(kid), depth+1)
+		}
+	}
+	if d.root != nil {
+		walk(d.dict(d.root["Pages"]), 0)
+	}
+
+	// Если дерево страниц не удалось разобрать, берутся все объекты-страницы
+	if len(pages) == 0 {
+		for num := 0; num <= d.maxObjectNumber(); num++ {
+			obj, ok := d.objects[num]
+			if !ok {
+				continue
+			}
+			if dict, ok := obj.value.(pdfDict); ok && dict["Type"] == pdfName("Page") {
+				pages = append(pages, dict)
+			}
+		}
+	}
+	return pages
+}
+
+func (d *pdfDocument) maxObjectNumber() int {
+	maxNum := 0
+	for num := range d.objects {
+		maxNum = max(maxNum, num)
+	}
+	return maxNum
+}
+
+// resources возвращает ресурсы страницы с учетом наследования от родительских узлов
+func (d *pdfDocument) resources(page pdfDict) pdfDict {
+	for node, depth := page, 0; node != nil && depth < maxPDFDepth; depth++ {
+		if res := d.dict(node["Resources"]); res != nil {
+			return res
+		}
+		node = d.dict(node["Parent"])
+	}
+	return nil
+}
+
+func (d *pdfDocument) renderPage(b *strings.Builder, page pdfDict) {
+	var content []byte
+	switch contents := d.resolve(page["Contents"]).(type) {
+	case pdfArray:
+		for _, part := range contents {
+			data, _ := d.streamOf(part)
+			content = append(content, data...)
+			content = append(content, '\n')
+		}
+	default:
+		content, _ = d.streamOf(page["Contents"])
+	}
+	d.renderContent(b, content, d.resources(page), 0)
+}
+
+// renderContent выполняет текстовые операторы потока содержимого
+func (d *pdfDocument) renderContent(b *strings.Builder, content []byte, resources pdfDict, depth int) {
+	if depth > maxPDFDepth {
+		return
+	}
+
+	fonts := d.dict(resources["Font"])
+	xobjects := d.dict(resources["XObject"])
+	var font *pdfFont
+	var operands []any
+	lastY := 0.0
+
+	lx := &pdfLexer{data: content}
+	for {
+		tok := lx.value()
+		if tok == nil && lx.pos >= len(lx.data) {
+			return
+		}
+		op, ok := tok.(pdfKeyword)
+		if !ok {
+			operands = append(operands, tok)
+			continue
+		}
+
+		switch op {
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(pdfName); ok {
+					font = d.font(fonts[name])
+				}
+			}
+		case "Tj":
+			if len(operands) > 0 {
+				b.WriteString(font.decode(operands[len(operands)-1]))
+			}
+		case "'", "\"":
+			b.WriteByte('\n')
+			if len(operands) > 0 {
+				b.WriteString(font.decode(operands[len(operands)-1]))
+			}
+		case "TJ":
+			if len(operands) > 0 {
+				items, _ := operands[len(operands)-1].(pdfArray)
+				for _, item := range items {
+					// Большой отрицательный сдвиг между частями строки означает пробел
+					if shift, ok := item.(float64); ok && shift < -200 {
+						b.WriteByte(' ')
+						continue
+					}
+					b.WriteString(font.decode(item))
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				if ty, _ := operands[len(operands)-1].(float64); ty != 0 {
+					b.WriteByte('\n')
+				} else {
+					b.WriteByte(' ')
+				}
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				y, _ := operands[len(operands)-1].(float64)
+				if y != lastY {
+					b.WriteByte('\n')
+				} else {
+					b.WriteByte(' ')
+				}
+				lastY = y
+			}
+		case "T*", "ET":
+			b.WriteByte('\n')
+		case "Do":
+			if len(operands) > 0 {
+				if name, ok := operands[len(operands)-1].(pdfName); ok {
+					data, dict := d.streamOf(xobjects[name])
+					if dict["Subtype"] == pdfName("Form") {
+						formResources := d.dict(dict["Resources"])
+						if formResources == nil {
+							formResources = resources
+						}
+						d.renderContent(b, data, formResources, depth+1)
+					}
+				}
+			}
+		case "ID":
+			lx.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+// pdfFont декодирует строки, выводимые шрифтом
+type pdfFont struct {
+	cmap     *toUnicode
+	codeSize int
+}
+
+func (d *pdfDocument) font(ref any) *pdfFont {
+	dict := d.dict(ref)
+	if dict == nil {
+		return nil
+	}
+
+	font := &pdfFont{codeSize: 1}
+	if dict["Subtype"] == pdfName("Type0") {
+		font.codeSize = 2
+	}
+	if cmapRef, ok := dict["ToUnicode"].(pdfRef); ok {
+		if cached, ok := d.cmaps[int(cmapRef)]; ok {
+			font.cmap = cached
+		} else if data, _ := d.streamOf(cmapRef); data != nil {
+			font.cmap = parseToUnicode(data)
+			d.cmaps[int(cmapRef)] = font.cmap
+		}
+	}
+	if font.cmap != nil && font.cmap.codeSize > 0 {
+		font.codeSize = font.cmap.codeSize
+	}
+	return font
+}
+
+func (f *pdfFont) decode(v any) string {
+	s, ok := v.(pdfString)
+	if !ok {
+		return ""
+	}
+
+	if f != nil && f.cmap != nil {
+		var b strings.Builder
+		for i := 0; i+f.codeSize <= len(s); i += f.codeSize {
+			code := 0
+			for _, c := range s[i : i+f.codeSize] {
+				code = code<<8 | int(c)
+			}
+			b.WriteString(f.cmap.codes[code])
+		}
+		return b.String()
+	}
+
+	if f != nil && f.codeSize == 2 {
+		// Составной шрифт без ToUnicode: коды глифов нельзя перевести в текст
+		return ""
+	}
+	return decodePDFText(s)
+}
+
+// decodePDFText декодирует строку PDF без таблицы шрифта: UTF-16BE с BOM
+// или однобайтовая кодировка, близкая к WinAnsi
+func decodePDFText(s []byte) string {
+	if len(s) >= 2 && s[0] == 0xFE && s[1] == 0xFF {
+		return decodeUTF16BE(s[2:])
+	}
+	var b strings.Builder
+	for _, c := range s {
+		b.WriteRune(charmap.Windows1252.DecodeByte(c))
+	}
+	return b.String()
+}
+
+func decodeUTF16BE(s []byte) string {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
+
+// toUnicode таблица соответствия кодов шрифта символам Unicode
+type toUnicode struct {
+	codeSize int
+	codes    map[int]string
+}
+
+func parseToUnicode(data []byte) *toUnicode {
+	cmap := &toUnicode{codes: make(map[int]string)}
+	lx := &pdfLexer{data: data}
+	var operands []any
+
+	for lx.pos < len(lx.data) {
+		tok := lx.value()
+		kw, ok := tok.(pdfKeyword)
+		if !ok {
+			if tok != nil {
+				operands = append(operands, tok)
+			}
+			continue
+		}
+
+		switch kw {
+		case "endcodespacerange":
+			if len(operands) > 0 {
+				if lo, ok := operands[0].(pdfString); ok {
+					cmap.codeSize = len(lo)
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					cmap.codes[codeOf(src)] = decodeUTF16BE(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 {
+					continue
+				}
+				start, end := codeOf(lo), codeOf(hi)
+				if end < start || end-start > 0xFFFF {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case pdfString:
+					base := []rune(decodeUTF16BE(dst))
+					if len(base) == 0 {
+						continue
+					}
+					for code := start; code <= end; code++ {
+						r := append([]rune(nil), base...)
+						r[len(r)-1] += rune(code - start)
+						cmap.codes[code] = string(r)
+					}
+				case pdfArray:
+					for j, item := range dst {
+						if s, ok := item.(pdfString); ok && start+j <= end {
+							cmap.codes[start+j] = decodeUTF16BE(s)
+						}
+					}
+				}
+			}
+		}
+		if strings.HasPrefix(string(kw), "end") || strings.HasPrefix(string(kw), "begin") {
+			operands = operands[:0]
+		}
+	}
+	return cmap
+}
+
+func codeOf(s []byte) int {
+	code := 0
+	for _, c := range s {
+		code = code<<8 | int(c)
+	}
+	return code
+}
+
+// sanitizeText удаляет управляющие символы, кроме переводов строк и табуляции
+func sanitizeText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// pdfLexer разбирает синтаксис PDF-объектов и потоков содержимого
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// value читает следующее значение целиком: словари, массивы и ссылки вида "N G R"
+func (lx *pdfLexer) value() any {
+	tok := lx.next()
+	switch t := tok.(type) {
+	case pdfKeyword:
+		switch t {
+		case "[":
+			var arr pdfArray
+			for lx.pos < len(lx.data) {
+				v := lx.value()
+				if _, end := v.(pdfEndArray); end {
+					break
+				}
+				arr = append(arr, v)
+			}
+			return arr
+		case "<<":
+			dict := make(pdfDict)
+			for lx.pos < len(lx.data) {
+				k := lx.value()
+				if _, end := k.(pdfEndDict); end {
+					break
+				}
+				name, ok := k.(pdfName)
+				if !ok {
+					continue
+				}
+				dict[name] = lx.value()
+			}
+			return dict
+		case "]":
+			return pdfEndArray{}
+		case ">>":
+			return pdfEndDict{}
+		}
+	case float64:
+		// Ссылка "N G R" распознается заглядыванием вперед
+		save := lx.pos
+		if gen, ok := lx.next().(float64); ok && gen >= 0 {
+			if kw, ok := lx.next().(pdfKeyword); ok && kw == "R" {
+				return pdfRef(int(t))
+			}
+		}
+		lx.pos = save
+	}
+	return tok
+}
+
+// next читает один токен
+func (lx *pdfLexer) next() any {
+	for lx.pos < len(lx.data) {
+		c := lx.data[lx.pos]
+		if isPDFWhitespace(c) {
+			lx.pos++
+			continue
+		}
+		if c == '%' {
+			for lx.pos < len(lx.data) && lx.data[lx.pos] != '\n' && lx.data[lx.pos] != '\r' {
+				lx.pos++
+			}
+			continue
+		}
+		break
+	}
+	if lx.pos >= len(lx.data) {
+		return nil
+	}
+
+	c := lx.data[lx.pos]
+	switch {
+	case c == '(':
+		return lx.literalString()
+	case c == '<' && lx.pos+1 < len(lx.data) && lx.data[lx.pos+1] == '<':
+		lx.pos += 2
+		return pdfKeyword("<<")
+	case c == '>' && lx.pos+1 < len(lx.data) && lx.data[lx.pos+1] == '>':
+		lx.pos += 2
+		return pdfKeyword(">>")
+	case c == '<':
+		end := bytes.IndexByte(lx.data[lx.pos:], '>')
+		if end < 0 {
+			end = len(lx.data) - lx.pos
+		}
+		raw := lx.data[lx.pos+1 : lx.pos+end]
+		lx.pos = min(lx.pos+end+1, len(lx.data))
+		decoded, _ := decodeHex(raw)
+		return pdfString(decoded)
+	case c == '[' || c == ']' || c == '{' || c == '}':
+		lx.pos++
+		return pdfKeyword(string(c))
+	case c == '/':
+		lx.pos++
+		return pdfName(lx.regular())
+	}
+
+	word := lx.regular()
+	if word == "" {
+		// Непарный разделитель, например ')' или '>'
+		lx.pos++
+		return lx.next()
+	}
+	if n, err := strconv.ParseFloat(word, 64); err == nil {
+		return n
+	}
+	switch word {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	return pdfKeyword(word)
+}
+
+// regular читает последовательность обычных (не разделительных) символов
+func (lx *pdfLexer) regular() string {
+	start := lx.pos
+	for lx.pos < len(lx.data) && !isPDFWhitespace(lx.data[lx.pos]) && !isPDFDelimiter(lx.data[lx.pos]) {
+		lx.pos++
+	}
+	return string(lx.data[start:lx.pos])
+}
+
+func (lx *pdfLexer) literalString() pdfString {
+	lx.pos++ // '('
+	var out []byte
+	depth := 1
+	for lx.pos < len(lx.data) {
+		c := lx.data[lx.pos]
+		lx.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if lx.pos >= len(lx.data) {
+				return out
+			}
+			e := lx.data[lx.pos]
+			lx.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				if lx.pos < len(lx.data) && lx.data[lx.pos] == '\n' {
+					lx.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && lx.pos < len(lx.data) && lx.data[lx.pos] >= '0' && lx.data[lx.pos] <= '7'; i++ {
+						v = v*8 + int(lx.data[lx.pos]-'0')
+						lx.pos++
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// skipInlineImage пропускает двоичные данные встроенного изображения до оператора EI
+func (lx *pdfLexer) skipInlineImage() {
+	for lx.pos+2 < len(lx.data) {
+		if isPDFWhitespace(lx.data[lx.pos]) && lx.data[lx.pos+1] == 'E' && lx.data[lx.pos+2] == 'I' &&
+			(lx.pos+3 == len(lx.data) || isPDFWhitespace(lx.data[lx.pos+3])) {
+			lx.pos += 3
+			return
+		}
+		lx.pos++
+	}
+	lx.pos = len(lx.data)
+}
@@ -0,0 +1,174 @@
+package extractor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// rtfExtractor извлекает текст из документа RTF
+type rtfExtractor struct{}
+
+func (rtfExtractor) Name() string {
+	return "rtf"
+}
+
+// rtfDestinations группы, содержимое которых не является текстом документа
+var rtfDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true, "pict": true,
+	"header": true, "footer": true, "headerl": true, "headerr": true, "footerl": true,
+	"footerr": true, "object": true, "themedata": true, "datastore": true, "listtable": true,
+	"listoverridetable": true, "rsidtbl": true, "latentstyles": true, "xmlnstbl": true,
+	"generator": true, "fldinst": true,
+}
+
+// rtfCodePages однобайтовые кодировки для символов \'hh, по значению \ansicpg
+var rtfCodePages = map[int]*charmap.Charmap{
+	866:  charmap.CodePage866,
+	1250: charmap.Windows1250,
+	1251: charmap.Windows1251,
+	1252: charmap.Windows1252,
+	1253: charmap.Windows1253,
+	1254: charmap.Windows1254,
+	1257: charmap.Windows1257,
+}
+
+type rtfGroup struct {
+	skip        bool
+	unicodeSkip int
+}
+
+func (rtfExtractor) Extract(content []byte) (string, error) {
+	text := string(content)
+	if !strings.HasPrefix(strings.TrimSpace(text), `{\rtf`) {
+		return "", fmt.Errorf("not an RTF document")
+	}
+
+	var b strings.Builder
+	codePage := charmap.Windows1252
+	stack := []rtfGroup{{unicodeSkip: 1}}
+	// pendingSkip количество символов-заменителей, которые нужно пропустить после \uN
+	pendingSkip := 0
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		state := &stack[len(stack)-1]
+
+		switch c {
+		case '{':
+			stack = append(stack, *state)
+			pendingSkip = 0
+			i++
+		case '}':
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			pendingSkip = 0
+			i++
+		case '\\':
+			word, param, hasParam, next := readControlWord(text, i+1)
+			i = next
+			switch {
+			case word == "*":
+				state.skip = true
+			case rtfDestinations[word]:
+				state.skip = true
+			case word == "ansicpg" && hasParam:
+				if cp, ok := rtfCodePages[param]; ok {
+					codePage = cp
+				}
+			case word == "uc" && hasParam:
+				state.unicodeSkip = param
+			case word == "u" && hasParam:
+				if !state.skip {
+					if param < 0 {
+						param += 65536
+					}
+					b.WriteRune(rune(param))
+				}
+				pendingSkip = state.unicodeSkip
+			case word == "'":
+				hex := text[i:min(i+2, len(text))]
+				i = min(i+2, len(text))
+				if pendingSkip > 0 {
+					pendingSkip--
+					continue
+				}
+				if v, err := strconv.ParseUint(hex, 16, 8); err == nil && !state.skip {
+					b.WriteRune(codePage.DecodeByte(byte(v)))
+				}
+			case state.skip:
+			case word == "par" || word == "line" || word == "sect" || word == "page" || word == "row":
+				b.WriteByte('\n')
+			case word == "tab" || word == "cell":
+				b.WriteByte('\t')
+			case word == "emdash":
+				b.WriteString("—")
+			case word == "endash":
+				b.WriteString("–")
+			case word == "lquote" || word == "rquote":
+				b.WriteString("'")
+			case word == "ldblquote" || word == "rdblquote":
+				b.WriteString("\"")
+			case word == "~":
+				b.WriteString(" ")
+			case word == "\\" || word == "{" || word == "}":
+				b.WriteString(word)
+			}
+		case '\r', '\n':
+			i++
+		default:
+			if pendingSkip > 0 {
+				pendingSkip--
+			} else if !state.skip {
+				b.WriteByte(c)
+			}
+			i++
+		}
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// readControlWord разбирает управляющее слово RTF, начинающееся после обратной
+// косой черты в позиции i. Возвращает слово, числовой параметр и позицию за ним.
+func readControlWord(text string, i int) (word string, param int, hasParam bool, next int) {
+	if i >= len(text) {
+		return "", 0, false, i
+	}
+	if !isLetter(text[i]) {
+		// Управляющий символ: \', \*, \~, \\, \{, \}
+		return text[i : i+1], 0, false, i + 1
+	}
+
+	start := i
+	for i < len(text) && isLetter(text[i]) {
+		i++
+	}
+	word = text[start:i]
+
+	numStart := i
+	if i < len(text) && text[i] == '-' {
+		i++
+	}
+	for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+		i++
+	}
+	if i > numStart {
+		if v, err := strconv.Atoi(text[numStart:i]); err == nil {
+			param, hasParam = v, true
+		}
+	}
+
+	// Пробел после управляющего слова является разделителем и не входит в текст
+	if i < len(text) && text[i] == ' ' {
+		i++
+	}
+	return word, param, hasParam, i
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
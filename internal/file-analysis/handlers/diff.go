@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+
+	fileanalysis "sd_hw3/api/generated/file-analysis"
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/internal/file-analysis/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:embed templates/diff.html
+var diffTemplateSource string
+
+var diffTemplate = template.Must(template.New("diff").Parse(diffTemplateSource))
+
+// segment часть текста файла; выделенные части относятся к фрагменту с номером
+// Fragment, Merged - остальные фрагменты, попавшие в ту же часть при объединении
+type segment struct {
+	Text        string
+	Highlighted bool
+	Fragment    int
+	Merged      []int
+}
+
+type diffPage struct {
+	*models.Comparison
+	Original []segment
+	Similar  []segment
+}
+
+// GetReportDiff отображает проверяемый и похожий файлы рядом с подсветкой совпадений
+func (h *Handler) GetReportDiff(ctx echo.Context, reportId string, similarId string) error {
+	comparison, err := h.service.GetComparison(ctx.Request().Context(), reportId, similarId)
+	if errors.Is(err, service.ErrNotFound) {
+		return ctx.JSON(http.StatusNotFound, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("SIMILAR_WORK_NOT_FOUND")),
+			Message: stringPtr(fmt.Sprintf("Similar work %s not found in report %s", similarId, reportId)),
+		})
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("COMPARISON_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to build comparison: %v", err)),
+		})
+	}
+
+	originalSpans := make([]span, len(comparison.SimilarWork.Fragments))
+	similarSpans := make([]span, len(comparison.SimilarWork.Fragments))
+	for i, f := range comparison.SimilarWork.Fragments {
+		originalSpans[i] = span{offset: f.OriginalOffset, length: f.OriginalLength, fragment: i}
+		similarSpans[i] = span{offset: f.SimilarOffset, length: f.SimilarLength, fragment: i}
+	}
+
+	page := diffPage{
+		Comparison: comparison,
+		Original:   splitSegments(comparison.OriginalText, originalSpans),
+		Similar:    splitSegments(comparison.SimilarText, similarSpans),
+	}
+
+	var buf bytes.Buffer
+	if err := diffTemplate.Execute(&buf, page); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("RENDER_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to render comparison: %v", err)),
+		})
+	}
+
+	return ctx.HTMLBlob(http.StatusOK, buf.Bytes())
+}
+
+type span struct {
+	offset   int
+	length   int
+	fragment int
+}
+
+// splitSegments разбивает текст на обычные и выделенные части. Пересекающиеся
+// участки объединяются, объединенный участок ссылается на первый из фрагментов.
+func splitSegments(text string, spans []span) []segment {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].offset < spans[j].offset })
+
+	var segments []segment
+	pos := 0
+	for i := 0; i < len(spans); i++ {
+		start := min(max(spans[i].offset, pos), len(text))
+		end := min(spans[i].offset+spans[i].length, len(text))
+		fragment := spans[i].fragment
+		var merged []int
+		for i+1 < len(spans) && spans[i+1].offset <= end {
+			i++
+			end = max(end, min(spans[i].offset+spans[i].length, len(text)))
+			merged = append(merged, spans[i].fragment)
+		}
+		if end <= start {
+			continue
+		}
+		if start > pos {
+			segments = append(segments, segment{Text: text[pos:start]})
+		}
+		segments = append(segments, segment{Text: text[start:end], Highlighted: true, Fragment: fragment, Merged: merged})
+		pos = end
+	}
+	if pos < len(text) {
+		segments = append(segments, segment{Text: text[pos:]})
+	}
+
+	return segments
+}
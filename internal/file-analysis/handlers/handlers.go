@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -14,17 +15,19 @@ import (
 
 // Handler реализует ServerInterface из сгенерированного кода
 type Handler struct {
-	service service.AnalysisService
+	service  service.AnalysisService
+	policies service.PolicyService
 }
 
 // NewHandler создает новый обработчик
-func NewHandler(svc service.AnalysisService) *Handler {
+func NewHandler(svc service.AnalysisService, policies service.PolicyService) *Handler {
 	return &Handler{
-		service: svc,
+		service:  svc,
+		policies: policies,
 	}
 }
 
-// AnalyzeFile анализирует файл и создает отчет о плагиате
+// AnalyzeFile ставит файл в очередь анализа и возвращает отчет в статусе pending
 func (h *Handler) AnalyzeFile(ctx echo.Context) error {
 	var req models.AnalysisRequest
 
@@ -46,11 +49,81 @@ func (h *Handler) AnalyzeFile(ctx echo.Context) error {
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
 			Error:   (*fileanalysis.ApiErrorError)(stringPtr("ANALYSIS_ERROR")),
-			Message: stringPtr(fmt.Sprintf("Failed to analyze file: %v", err)),
+			Message: stringPtr(fmt.Sprintf("Failed to queue file for analysis: %v", err)),
 		})
 	}
 
-	return ctx.JSON(http.StatusCreated, mapReportToResponse(report))
+	return ctx.JSON(http.StatusAccepted, mapReportToResponse(report))
+}
+
+// ReanalyzeReport ставит в очередь повторный анализ файла отчета, создавая новую версию отчета
+func (h *Handler) ReanalyzeReport(ctx echo.Context, reportId string) error {
+	report, err := h.service.ReanalyzeReport(ctx.Request().Context(), reportId)
+	if errors.Is(err, service.ErrNotFound) {
+		return ctx.JSON(http.StatusNotFound, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("REPORT_NOT_FOUND")),
+			Message: stringPtr(fmt.Sprintf("Report with id %s not found", reportId)),
+		})
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("ANALYSIS_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to queue file for re-analysis: %v", err)),
+		})
+	}
+
+	return ctx.JSON(http.StatusAccepted, mapReportToResponse(report))
+}
+
+// DeleteReport удаляет отчет
+func (h *Handler) DeleteReport(ctx echo.Context, reportId string) error {
+	err := h.service.DeleteReport(ctx.Request().Context(), reportId)
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return ctx.JSON(http.StatusNotFound, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("REPORT_NOT_FOUND")),
+			Message: stringPtr(fmt.Sprintf("Report with id %s not found", reportId)),
+		})
+	case errors.Is(err, service.ErrReportInProgress):
+		return ctx.JSON(http.StatusConflict, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("REPORT_IN_PROGRESS")),
+			Message: stringPtr(err.Error()),
+		})
+	case err != nil:
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("DELETE_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to delete report: %v", err)),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ReanalyzeAssignment ставит в очередь повторный анализ всех файлов задания
+func (h *Handler) ReanalyzeAssignment(ctx echo.Context, assignmentId string) error {
+	reports, err := h.service.ReanalyzeAssignment(ctx.Request().Context(), assignmentId)
+	if errors.Is(err, service.ErrNotFound) {
+		return ctx.JSON(http.StatusNotFound, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("REPORTS_NOT_FOUND")),
+			Message: stringPtr(fmt.Sprintf("No reports found for assignment %s", assignmentId)),
+		})
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("ANALYSIS_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to queue assignment for re-analysis (%d files queued): %v", len(reports), err)),
+		})
+	}
+
+	var response []map[string]interface{}
+	for _, report := range reports {
+		response = append(response, mapReportToResponse(report))
+	}
+
+	return ctx.JSON(http.StatusAccepted, map[string]interface{}{
+		"reports": response,
+		"count":   len(response),
+	})
 }
 
 // GetReport получает отчет по ID
@@ -139,6 +212,9 @@ func mapReportToResponse(report *models.Report) map[string]interface{} {
 		"word_count":           report.WordCount,
 		"analysis_duration_ms": report.AnalysisDurationMs,
 		"status":               report.Status,
+		"analysis_mode":        report.AnalysisMode,
+		"version":              report.Version,
+		"is_latest":            report.IsLatest,
 		"created_at":           report.CreatedAt,
 	}
 
@@ -146,19 +222,57 @@ func mapReportToResponse(report *models.Report) map[string]interface{} {
 		response["error_message"] = *report.ErrorMessage
 	}
 
+	if report.ResubmissionOf != nil {
+		response["resubmission_of"] = *report.ResubmissionOf
+	}
+
+	if len(report.FileIDs) > 0 {
+		response["file_ids"] = report.FileIDs
+	}
+
 	if len(report.SimilarWorks) > 0 {
 		var similarWorks []map[string]interface{}
 		for _, sw := range report.SimilarWorks {
-			similarWorks = append(similarWorks, map[string]interface{}{
+			similarWork := map[string]interface{}{
 				"similar_id":            sw.SimilarID,
 				"report_id":             sw.ReportID,
 				"original_work_id":      sw.OriginalWorkID,
 				"similar_work_id":       sw.SimilarWorkID,
 				"similarity_percentage": sw.SimilarityPercentage,
-			})
+				"is_self_match":         sw.IsSelfMatch,
+				"fragments":             mapFragmentsToResponse(sw.Fragments),
+			}
+			if sw.OriginalPath != nil {
+				similarWork["original_path"] = *sw.OriginalPath
+			}
+			if sw.SimilarPath != nil {
+				similarWork["similar_path"] = *sw.SimilarPath
+			}
+			similarWorks = append(similarWorks, similarWork)
 		}
 		response["similar_works"] = similarWorks
 	}
 
 	return response
 }
+
+func mapFragmentsToResponse(fragments []models.MatchedFragment) []map[string]interface{} {
+	response := make([]map[string]interface{}, 0, len(fragments))
+	for _, f := range fragments {
+		response = append(response, map[string]interface{}{
+			"original": map[string]interface{}{
+				"offset":     f.OriginalOffset,
+				"length":     f.OriginalLength,
+				"start_line": f.OriginalStartLine,
+				"end_line":   f.OriginalEndLine,
+			},
+			"similar": map[string]interface{}{
+				"offset":     f.SimilarOffset,
+				"length":     f.SimilarLength,
+				"start_line": f.SimilarStartLine,
+				"end_line":   f.SimilarEndLine,
+			},
+		})
+	}
+	return response
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	fileanalysis "sd_hw3/api/generated/file-analysis"
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/internal/file-analysis/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListPolicies список политик анализа по заданиям
+func (h *Handler) ListPolicies(ctx echo.Context) error {
+	policies, err := h.policies.ListPolicies(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("FETCH_ERROR")),
+			Message: stringPtr(fmt.Sprintf("Failed to fetch policies: %v", err)),
+		})
+	}
+
+	response := make([]fileanalysis.Policy, 0, len(policies))
+	for _, policy := range policies {
+		response = append(response, mapPolicyToResponse(policy))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"policies": response,
+		"count":    len(response),
+	})
+}
+
+// CreatePolicy создает политику задания; незаданные поля берутся из политики по умолчанию
+func (h *Handler) CreatePolicy(ctx echo.Context) error {
+	var req fileanalysis.Policy
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("INVALID_REQUEST")),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+	if req.AssignmentId == nil || *req.AssignmentId == "" {
+		return ctx.JSON(http.StatusBadRequest, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("MISSING_REQUIRED_FIELDS")),
+			Message: stringPtr("assignment_id is required"),
+		})
+	}
+
+	policy := h.policies.DefaultPolicy(*req.AssignmentId)
+	applyPolicyRequest(policy, &req)
+
+	if err := h.policies.CreatePolicy(ctx.Request().Context(), policy); err != nil {
+		return policyError(ctx, err, *req.AssignmentId)
+	}
+
+	return ctx.JSON(http.StatusCreated, mapPolicyToResponse(policy))
+}
+
+// GetPolicy получает политику задания
+func (h *Handler) GetPolicy(ctx echo.Context, assignmentId string) error {
+	policy, err := h.policies.GetPolicy(ctx.Request().Context(), assignmentId)
+	if err != nil {
+		return policyError(ctx, err, assignmentId)
+	}
+
+	return ctx.JSON(http.StatusOK, mapPolicyToResponse(policy))
+}
+
+// UpdatePolicy изменяет политику задания; незаданные поля сохраняют текущие значения
+func (h *Handler) UpdatePolicy(ctx echo.Context, assignmentId string) error {
+	var req fileanalysis.Policy
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("INVALID_REQUEST")),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+
+	policy, err := h.policies.GetPolicy(ctx.Request().Context(), assignmentId)
+	if err != nil {
+		return policyError(ctx, err, assignmentId)
+	}
+	applyPolicyRequest(policy, &req)
+
+	if err := h.policies.UpdatePolicy(ctx.Request().Context(), policy); err != nil {
+		return policyError(ctx, err, assignmentId)
+	}
+
+	return ctx.JSON(http.StatusOK, mapPolicyToResponse(policy))
+}
+
+// DeletePolicy удаляет политику задания, после чего действует политика по умолчанию
+func (h *Handler) DeletePolicy(ctx echo.Context, assignmentId string) error {
+	if err := h.policies.DeletePolicy(ctx.Request().Context(), assignmentId); err != nil {
+		return policyError(ctx, err, assignmentId)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// applyPolicyRequest переносит в политику заданные в запросе поля
+func applyPolicyRequest(policy *models.Policy, req *fileanalysis.Policy) {
+	if req.PlagiarismThreshold != nil {
+		policy.PlagiarismThreshold = *req.PlagiarismThreshold
+	}
+	if req.MinSimilarityPercentage != nil {
+		policy.MinSimilarityPercentage = *req.MinSimilarityPercentage
+	}
+	if req.MinMatchLength != nil {
+		policy.MinMatchLength = *req.MinMatchLength
+	}
+	if req.Algorithm != nil {
+		policy.Algorithm = string(*req.Algorithm)
+	}
+	if req.IgnoreTemplateCode != nil {
+		policy.IgnoreTemplateCode = *req.IgnoreTemplateCode
+	}
+	if req.CompareAllRevisions != nil {
+		policy.CompareAllRevisions = *req.CompareAllRevisions
+	}
+}
+
+func policyError(ctx echo.Context, err error, assignmentId string) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPolicy):
+		return ctx.JSON(http.StatusBadRequest, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("INVALID_POLICY")),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrPolicyExists):
+		return ctx.JSON(http.StatusConflict, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("POLICY_EXISTS")),
+			Message: stringPtr(fmt.Sprintf("Policy for assignment %s already exists", assignmentId)),
+		})
+	case errors.Is(err, service.ErrNotFound):
+		return ctx.JSON(http.StatusNotFound, fileanalysis.ApiError{
+			Error:   (*fileanalysis.ApiErrorError)(stringPtr("POLICY_NOT_FOUND")),
+			Message: stringPtr(fmt.Sprintf("Policy for assignment %s not found", assignmentId)),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, fileanalysis.ApiError{
+		Error:   (*fileanalysis.ApiErrorError)(stringPtr("POLICY_ERROR")),
+		Message: stringPtr(fmt.Sprintf("Failed to process policy: %v", err)),
+	})
+}
+
+func mapPolicyToResponse(policy *models.Policy) fileanalysis.Policy {
+	algorithm := fileanalysis.PolicyAlgorithm(policy.Algorithm)
+	return fileanalysis.Policy{
+		AssignmentId:            &policy.AssignmentID,
+		PlagiarismThreshold:     &policy.PlagiarismThreshold,
+		MinSimilarityPercentage: &policy.MinSimilarityPercentage,
+		MinMatchLength:          &policy.MinMatchLength,
+		Algorithm:               &algorithm,
+		IgnoreTemplateCode:      &policy.IgnoreTemplateCode,
+		CreatedAt:               &policy.CreatedAt,
+		UpdatedAt:               &policy.UpdatedAt,
+		CompareAllRevisions:     &policy.CompareAllRevisions,
+	}
+}
@@ -1,10 +1,30 @@
 package models
 
-func MapFileIDToSimilarWorks(originalId string, fileIDs string, reportID string) *SimilarWork {
+import "sd_hw3/internal/file-analysis/similarity"
+
+func MapFileIDToSimilarWorks(originalId string, fileIDs string, reportID string, similarityPercentage float32) *SimilarWork {
 	return &SimilarWork{
 		OriginalWorkID:       originalId,
 		SimilarWorkID:        fileIDs,
 		ReportID:             reportID,
-		SimilarityPercentage: 100.0,
+		SimilarityPercentage: similarityPercentage,
+	}
+}
+
+func MapFragments(similarID string, fragments []similarity.Fragment) []MatchedFragment {
+	result := make([]MatchedFragment, len(fragments))
+	for i, f := range fragments {
+		result[i] = MatchedFragment{
+			SimilarID:         similarID,
+			OriginalOffset:    f.Source.Offset,
+			OriginalLength:    f.Source.Length,
+			OriginalStartLine: f.Source.StartLine,
+			OriginalEndLine:   f.Source.EndLine,
+			SimilarOffset:     f.Target.Offset,
+			SimilarLength:     f.Target.Length,
+			SimilarStartLine:  f.Target.StartLine,
+			SimilarEndLine:    f.Target.EndLine,
+		}
 	}
+	return result
 }
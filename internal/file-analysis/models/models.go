@@ -15,22 +15,118 @@ type Report struct {
 	WordCount          int           `db:"word_count" json:"word_count"`
 	AnalysisDurationMs int           `db:"analysis_duration_ms" json:"analysis_duration_ms"`
 	Status             string        `db:"status" json:"status"`
+	AnalysisMode       string        `db:"analysis_mode" json:"analysis_mode"`
+	Version            int           `db:"version" json:"version"`
+	IsLatest           bool          `db:"is_latest" json:"is_latest"`
+	ResubmissionOf     *string       `db:"resubmission_of" json:"resubmission_of,omitempty"`
+	FileIDs            []string      `db:"file_ids" json:"file_ids,omitempty"`
 	ErrorMessage       *string       `db:"error_message" json:"error_message,omitempty"`
 	CreatedAt          time.Time     `db:"created_at" json:"created_at"`
 	SimilarWorks       []SimilarWork `json:"similar_works,omitempty"`
 }
 
 type SimilarWork struct {
-	SimilarID            string  `db:"similar_id" json:"similar_id"`
-	ReportID             string  `db:"report_id" json:"report_id"`
-	OriginalWorkID       string  `db:"original_work_id" json:"original_work_id"`
-	SimilarWorkID        string  `db:"similar_work_id" json:"similar_work_id"`
-	SimilarityPercentage float32 `db:"similarity_percentage" json:"similarity_percentage"`
+	SimilarID            string            `db:"similar_id" json:"similar_id"`
+	ReportID             string            `db:"report_id" json:"report_id"`
+	OriginalWorkID       string            `db:"original_work_id" json:"original_work_id"`
+	SimilarWorkID        string            `db:"similar_work_id" json:"similar_work_id"`
+	SimilarityPercentage float32           `db:"similarity_percentage" json:"similarity_percentage"`
+	IsSelfMatch          bool              `db:"is_self_match" json:"is_self_match"`
+	OriginalPath         *string           `db:"original_path" json:"original_path,omitempty"`
+	SimilarPath          *string           `db:"similar_path" json:"similar_path,omitempty"`
+	Fragments            []MatchedFragment `json:"fragments,omitempty"`
+}
+
+// MatchedFragment совпавший участок: смещения и длины в байтах, номера строк с 1
+type MatchedFragment struct {
+	SimilarID         string `db:"similar_id" json:"similar_id"`
+	OriginalOffset    int    `db:"original_offset" json:"original_offset"`
+	OriginalLength    int    `db:"original_length" json:"original_length"`
+	OriginalStartLine int    `db:"original_start_line" json:"original_start_line"`
+	OriginalEndLine   int    `db:"original_end_line" json:"original_end_line"`
+	SimilarOffset     int    `db:"similar_offset" json:"similar_offset"`
+	SimilarLength     int    `db:"similar_length" json:"similar_length"`
+	SimilarStartLine  int    `db:"similar_start_line" json:"similar_start_line"`
+	SimilarEndLine    int    `db:"similar_end_line" json:"similar_end_line"`
+}
+
+// Comparison данные для постраничного сравнения работы с похожей
+type Comparison struct {
+	Report       *Report
+	SimilarWork  SimilarWork
+	OriginalText string
+	SimilarText  string
 }
 
 type AnalysisRequest struct {
-	WorkID       string  `json:"work_id"`
-	FileID       string  `json:"file_id"`
-	StudentID    *string `json:"student_id,omitempty"`
-	AssignmentID *string `json:"assignment_id,omitempty"`
+	WorkID       string   `json:"work_id"`
+	FileID       string   `json:"file_id"`
+	FileIDs      []string `json:"file_ids,omitempty"`
+	StudentID    *string  `json:"student_id,omitempty"`
+	AssignmentID *string  `json:"assignment_id,omitempty"`
+}
+
+type Fingerprint struct {
+	FileID       string `db:"file_id" json:"file_id"`
+	ReportID     string `db:"report_id" json:"report_id"`
+	AssignmentID string `db:"assignment_id" json:"assignment_id"`
+	Hash         int64  `db:"hash" json:"hash"`
+	Position     int    `db:"position" json:"position"`
+}
+
+// ExtractedText простой текст, извлеченный из файла (PDF, DOCX и т.д.)
+type ExtractedText struct {
+	FileID    string    `db:"file_id" json:"file_id"`
+	Extractor string    `db:"extractor" json:"extractor"`
+	Text      string    `db:"text" json:"text"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// Статусы отчета
+const (
+	ReportStatusPending    = "pending"
+	ReportStatusProcessing = "processing"
+	ReportStatusCompleted  = "completed"
+	ReportStatusFailed     = "failed"
+)
+
+// Статусы задания в очереди анализа
+const (
+	JobStatusQueued  = "queued"
+	JobStatusRunning = "running"
+	JobStatusDone    = "done"
+	JobStatusFailed  = "failed"
+)
+
+// AnalysisJob задание на анализ файла в очереди. Данные для анализа
+// берутся из отчета, созданного вместе с заданием.
+type AnalysisJob struct {
+	JobID       int64      `db:"job_id" json:"job_id"`
+	ReportID    string     `db:"report_id" json:"report_id"`
+	Status      string     `db:"status" json:"status"`
+	Attempts    int        `db:"attempts" json:"attempts"`
+	LastError   *string    `db:"last_error" json:"last_error,omitempty"`
+	LockedUntil *time.Time `db:"locked_until" json:"locked_until,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// Policy правила анализа работ по заданию
+type Policy struct {
+	AssignmentID string `db:"assignment_id" json:"assignment_id"`
+	// PlagiarismThreshold процент заимствований, начиная с которого работа считается плагиатом
+	PlagiarismThreshold float32 `db:"plagiarism_threshold" json:"plagiarism_threshold"`
+	// MinSimilarityPercentage минимальное сходство, при котором работа попадает в similar_works
+	MinSimilarityPercentage float32 `db:"min_similarity_percentage" json:"min_similarity_percentage"`
+	// MinMatchLength минимальная длина совпадения в токенах (размер шингла)
+	MinMatchLength int `db:"min_match_length" json:"min_match_length"`
+	// Algorithm алгоритм сравнения: shingle или winnowing
+	Algorithm string `db:"algorithm" json:"algorithm"`
+	// IgnoreTemplateCode исключать совпадения с шаблонами задания
+	IgnoreTemplateCode bool      `db:"ignore_template_code" json:"ignore_template_code"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+	// CompareAllRevisions сравнивать и с прежними ревизиями работ других студентов,
+	// а не только с текущими
+	CompareAllRevisions bool `db:"compare_all_revisions" json:"compare_all_revisions"`
 }
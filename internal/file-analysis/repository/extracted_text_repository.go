@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/pkg/db"
+)
+
+// ExtractedTextRepository хранит текст, извлеченный из файлов, чтобы
+// не разбирать документ заново при повторном анализе
+type ExtractedTextRepository interface {
+	// GetExtractedText возвращает nil, если текст файла еще не извлекался
+	GetExtractedText(ctx context.Context, fileID string) (*models.ExtractedText, error)
+	SaveExtractedText(ctx context.Context, text *models.ExtractedText) error
+}
+
+type extractedTextRepository struct {
+	db *sql.DB
+}
+
+func NewExtractedTextRepository() ExtractedTextRepository {
+	return &extractedTextRepository{db: db.DB}
+}
+
+func (r *extractedTextRepository) GetExtractedText(ctx context.Context, fileID string) (*models.ExtractedText, error) {
+	query := `
+		SELECT file_id, extractor, text, created_at
+		FROM extracted_texts
+		WHERE file_id = $1
+	`
+
+	var text models.ExtractedText
+	err := db.QueryRow(ctx, query, fileID).Scan(&text.FileID, &text.Extractor, &text.Text, &text.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &text, nil
+}
+
+func (r *extractedTextRepository) SaveExtractedText(ctx context.Context, text *models.ExtractedText) error {
+	query := `
+		INSERT INTO extracted_texts (file_id, extractor, text, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (file_id) DO UPDATE
+		SET extractor = EXCLUDED.extractor, text = EXCLUDED.text, created_at = EXCLUDED.created_at
+	`
+
+	_, err := db.Exec(ctx, query, text.FileID, text.Extractor, text.Text, text.CreatedAt)
+	return err
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/pkg/db"
+
+	"github.com/lib/pq"
+)
+
+// FingerprintRepository индекс winnowing-отпечатков проанализированных файлов
+type FingerprintRepository interface {
+	SaveFingerprints(ctx context.Context, fileID string, fingerprints []models.Fingerprint) error
+	FindCandidates(ctx context.Context, assignmentID, excludeFileID string, hashes []int64, limit int) ([]string, error)
+}
+
+type fingerprintRepository struct {
+	db *sql.DB
+}
+
+func NewFingerprintRepository() FingerprintRepository {
+	return &fingerprintRepository{db: db.DB}
+}
+
+// SaveFingerprints заменяет отпечатки файла новыми
+func (r *fingerprintRepository) SaveFingerprints(ctx context.Context, fileID string, fingerprints []models.Fingerprint) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM fingerprints WHERE file_id = $1", fileID); err != nil {
+		return fmt.Errorf("failed to delete old fingerprints: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("fingerprints",
+		"file_id", "report_id", "assignment_id", "hash", "position"))
+	if err != nil {
+		return err
+	}
+	for _, fp := range fingerprints {
+		if _, err := stmt.ExecContext(ctx, fp.FileID, fp.ReportID, fp.AssignmentID, fp.Hash, fp.Position); err != nil {
+			stmt.Close()
+			return fmt.Errorf("failed to insert fingerprint: %w", err)
+		}
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		stmt.Close()
+		return fmt.Errorf("failed to flush fingerprints: %w", err)
+	}
+	if err := stmt.Close(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindCandidates возвращает файлы задания, имеющие общие отпечатки с переданными,
+// в порядке убывания числа совпавших отпечатков
+func (r *fingerprintRepository) FindCandidates(ctx context.Context, assignmentID, excludeFileID string, hashes []int64, limit int) ([]string, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT file_id
+		FROM fingerprints
+		WHERE assignment_id = $1 AND file_id != $2 AND hash = ANY($3)
+		GROUP BY file_id
+		ORDER BY COUNT(DISTINCT hash) DESC
+		LIMIT $4
+	`
+
+	rows, err := db.Query(ctx, query, assignmentID, excludeFileID, pq.Array(hashes), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fileIDs []string
+	for rows.Next() {
+		var fileID string
+		if err := rows.Scan(&fileID); err != nil {
+			return nil, err
+		}
+		fileIDs = append(fileIDs, fileID)
+	}
+
+	return fileIDs, rows.Err()
+}
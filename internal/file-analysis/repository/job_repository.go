@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/pkg/db"
+)
+
+// JobRepository очередь заданий на анализ, хранящаяся в Postgres
+type JobRepository interface {
+	EnqueueJob(ctx context.Context, reportID string) error
+	// ClaimJob забирает самое старое задание из очереди и блокирует его на время lease.
+	// Задание, чья блокировка истекла (обработчик упал или сервис перезапустился),
+	// снова становится доступным. Возвращает nil, если очередь пуста.
+	ClaimJob(ctx context.Context, lease time.Duration) (*models.AnalysisJob, error)
+	CompleteJob(ctx context.Context, jobID int64) error
+	FailJob(ctx context.Context, jobID int64, errorMsg string) error
+	// RetryJob возвращает задание в очередь через delay. Отложенная попытка
+	// не считается прерванной и не увеличивает attempts.
+	RetryJob(ctx context.Context, jobID int64, errorMsg string, delay time.Duration) error
+}
+
+type jobRepository struct {
+	db *sql.DB
+}
+
+func NewJobRepository() JobRepository {
+	return &jobRepository{db: db.DB}
+}
+
+func (r *jobRepository) EnqueueJob(ctx context.Context, reportID string) error {
+	query := `
+		INSERT INTO analysis_jobs (report_id, status)
+		VALUES ($1, $2)
+	`
+	_, err := db.Exec(ctx, query, reportID, models.JobStatusQueued)
+	return err
+}
+
+func (r *jobRepository) ClaimJob(ctx context.Context, lease time.Duration) (*models.AnalysisJob, error) {
+	// SKIP LOCKED позволяет нескольким обработчикам (и нескольким экземплярам
+	// сервиса) разбирать очередь, не блокируя друг друга
+	query := `
+		UPDATE analysis_jobs
+		SET status = $1, attempts = attempts + 1,
+			locked_until = NOW() + make_interval(secs => $2), updated_at = NOW()
+		WHERE job_id = (
+			SELECT job_id
+			FROM analysis_jobs
+			WHERE (status = $3 AND (locked_until IS NULL OR locked_until < NOW()))
+				OR (status = $1 AND locked_until < NOW())
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING job_id, report_id, status, attempts, last_error, locked_until, created_at, updated_at
+	`
+
+	var job models.AnalysisJob
+	err := db.QueryRow(ctx, query, models.JobStatusRunning, lease.Seconds(), models.JobStatusQueued).Scan(
+		&job.JobID,
+		&job.ReportID,
+		&job.Status,
+		&job.Attempts,
+		&job.LastError,
+		&job.LockedUntil,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *jobRepository) CompleteJob(ctx context.Context, jobID int64) error {
+	query := `
+		UPDATE analysis_jobs
+		SET status = $2, locked_until = NULL, updated_at = NOW()
+		WHERE job_id = $1
+	`
+	_, err := db.Exec(ctx, query, jobID, models.JobStatusDone)
+	return err
+}
+
+func (r *jobRepository) FailJob(ctx context.Context, jobID int64, errorMsg string) error {
+	query := `
+		UPDATE analysis_jobs
+		SET status = $2, last_error = $3, locked_until = NULL, updated_at = NOW()
+		WHERE job_id = $1
+	`
+	_, err := db.Exec(ctx, query, jobID, models.JobStatusFailed, errorMsg)
+	return err
+}
+
+func (r *jobRepository) RetryJob(ctx context.Context, jobID int64, errorMsg string, delay time.Duration) error {
+	// locked_until у задания в очереди - время, раньше которого его не берут
+	query := `
+		UPDATE analysis_jobs
+		SET status = $2, attempts = attempts - 1, last_error = $3,
+			locked_until = NOW() + make_interval(secs => $4), updated_at = NOW()
+		WHERE job_id = $1
+	`
+	_, err := db.Exec(ctx, query, jobID, models.JobStatusQueued, errorMsg, delay.Seconds())
+	return err
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/pkg/db"
+
+	"github.com/lib/pq"
+)
+
+// ErrPolicyExists политика для задания уже создана
+var ErrPolicyExists = errors.New("policy already exists")
+
+// PolicyRepository политики анализа по заданиям
+type PolicyRepository interface {
+	CreatePolicy(ctx context.Context, policy *models.Policy) error
+	// GetPolicy возвращает nil, если для задания политика не задана
+	GetPolicy(ctx context.Context, assignmentID string) (*models.Policy, error)
+	ListPolicies(ctx context.Context) ([]*models.Policy, error)
+	// UpdatePolicy возвращает false, если политики для задания нет
+	UpdatePolicy(ctx context.Context, policy *models.Policy) (bool, error)
+	// DeletePolicy возвращает false, если политики для задания нет
+	DeletePolicy(ctx context.Context, assignmentID string) (bool, error)
+}
+
+type policyRepository struct {
+	db *sql.DB
+}
+
+func NewPolicyRepository() PolicyRepository {
+	return &policyRepository{db: db.DB}
+}
+
+func (r *policyRepository) CreatePolicy(ctx context.Context, policy *models.Policy) error {
+	query := `
+		INSERT INTO policies (
+			assignment_id, plagiarism_threshold, min_similarity_percentage,
+			min_match_length, algorithm, ignore_template_code, created_at, updated_at,
+			compare_all_revisions
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := db.Exec(ctx, query,
+		policy.AssignmentID,
+		policy.PlagiarismThreshold,
+		policy.MinSimilarityPercentage,
+		policy.MinMatchLength,
+		policy.Algorithm,
+		policy.IgnoreTemplateCode,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+		policy.CompareAllRevisions,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrPolicyExists
+	}
+	return err
+}
+
+func (r *policyRepository) GetPolicy(ctx context.Context, assignmentID string) (*models.Policy, error) {
+	query := `
+		SELECT
+			assignment_id, plagiarism_threshold, min_similarity_percentage,
+			min_match_length, algorithm, ignore_template_code, created_at, updated_at,
+			compare_all_revisions
+		FROM policies
+		WHERE assignment_id = $1
+	`
+
+	var policy models.Policy
+	err := db.QueryRow(ctx, query, assignmentID).Scan(
+		&policy.AssignmentID,
+		&policy.PlagiarismThreshold,
+		&policy.MinSimilarityPercentage,
+		&policy.MinMatchLength,
+		&policy.Algorithm,
+		&policy.IgnoreTemplateCode,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+		&policy.CompareAllRevisions,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *policyRepository) ListPolicies(ctx context.Context) ([]*models.Policy, error) {
+	query := `
+		SELECT
+			assignment_id, plagiarism_threshold, min_similarity_percentage,
+			min_match_length, algorithm, ignore_template_code, created_at, updated_at,
+			compare_all_revisions
+		FROM policies
+		ORDER BY assignment_id
+	`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*models.Policy
+	for rows.Next() {
+		var policy models.Policy
+		err := rows.Scan(
+			&policy.AssignmentID,
+			&policy.PlagiarismThreshold,
+			&policy.MinSimilarityPercentage,
+			&policy.MinMatchLength,
+			&policy.Algorithm,
+			&policy.IgnoreTemplateCode,
+			&policy.CreatedAt,
+			&policy.UpdatedAt,
+			&policy.CompareAllRevisions,
+		)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, &policy)
+	}
+
+	return policies, rows.Err()
+}
+
+func (r *policyRepository) UpdatePolicy(ctx context.Context, policy *models.Policy) (bool, error) {
+	query := `
+		UPDATE policies
+		SET plagiarism_threshold = $2, min_similarity_percentage = $3, min_match_length = $4,
+			algorithm = $5, ignore_template_code = $6, updated_at = $7,
+			compare_all_revisions = $8
+		WHERE assignment_id = $1
+		RETURNING created_at
+	`
+
+	err := db.QueryRow(ctx, query,
+		policy.AssignmentID,
+		policy.PlagiarismThreshold,
+		policy.MinSimilarityPercentage,
+		policy.MinMatchLength,
+		policy.Algorithm,
+		policy.IgnoreTemplateCode,
+		policy.UpdatedAt,
+		policy.CompareAllRevisions,
+	).Scan(&policy.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *policyRepository) DeletePolicy(ctx context.Context, assignmentID string) (bool, error) {
+	result, err := db.Exec(ctx, "DELETE FROM policies WHERE assignment_id = $1", assignmentID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
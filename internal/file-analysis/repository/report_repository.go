@@ -3,21 +3,40 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
 	"sd_hw3/internal/file-analysis/models"
 	"sd_hw3/pkg/db"
+
+	"github.com/lib/pq"
 )
 
+// ErrReportNotFound отчета нет
+var ErrReportNotFound = errors.New("report not found")
+
+// isLatestColumn вычисляет, является ли отчет последней версией анализа своего файла
+const isLatestColumn = `NOT EXISTS (
+	SELECT 1 FROM reports newer
+	WHERE newer.file_id = reports.file_id AND newer.version > reports.version
+) AS is_latest`
+
 type ReportRepository interface {
 	CreateReport(ctx context.Context, report *models.Report) error
+	// GetReport возвращает ErrReportNotFound, если отчета нет
 	GetReport(ctx context.Context, reportID string) (*models.Report, error)
 	GetReportsByWorkID(ctx context.Context, workID string) ([]*models.Report, error)
+	// GetLatestReportsByAssignment возвращает последнюю версию отчета для каждого файла задания
+	GetLatestReportsByAssignment(ctx context.Context, assignmentID string) ([]*models.Report, error)
 	ListReports(ctx context.Context, params ListReportsParams) ([]*models.Report, int, error)
 	UpdateReportStatus(ctx context.Context, reportID, status string, errorMsg *string) error
+	// UpdateReportResult сохраняет результаты анализа, не меняя статус отчета
+	UpdateReportResult(ctx context.Context, report *models.Report) error
 	AddSimilarWork(ctx context.Context, similar *models.SimilarWork) error
 	GetSimilarWorks(ctx context.Context, reportID string) ([]models.SimilarWork, error)
+	// DeleteSimilarWorks удаляет похожие работы отчета, например оставшиеся от прерванного анализа
+	DeleteSimilarWorks(ctx context.Context, reportID string) error
 	DeleteReport(ctx context.Context, reportID string) error
 }
 
@@ -38,31 +57,54 @@ type ListReportsParams struct {
 	Offset       int
 }
 
+// createReportAttempts сколько раз CreateReport пытается занять номер версии,
+// если его одновременно занял отчет по тому же файлу
+const createReportAttempts = 5
+
 func (r *reportRepository) CreateReport(ctx context.Context, report *models.Report) error {
+	// Номер версии - следующий после последнего отчета по тому же файлу.
+	// Одновременные вставки могут вычислить одну и ту же версию: ограничение
+	// uq_reports_file_id_version отклонит одну из них, и она повторяется
 	query := `
 		INSERT INTO reports (
 			report_id, work_id, file_id, student_id, assignment_id,
 			plagiarism_score, is_plagiarism, word_count,
-			analysis_duration_ms, status, error_message, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			analysis_duration_ms, status, error_message, created_at, analysis_mode, file_ids, version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14,
+			(SELECT COALESCE(MAX(version), 0) + 1 FROM reports WHERE file_id = $3))
+		RETURNING version
 	`
 
-	_, err := db.Exec(ctx, query,
-		report.ReportID,
-		report.WorkID,
-		report.FileID,
-		report.StudentID,
-		report.AssignmentID,
-		report.PlagiarismScore,
-		report.IsPlagiarism,
-		report.WordCount,
-		report.AnalysisDurationMs,
-		report.Status,
-		report.ErrorMessage,
-		report.CreatedAt,
-	)
+	var err error
+	for attempt := 0; attempt < createReportAttempts; attempt++ {
+		err = db.QueryRow(ctx, query,
+			report.ReportID,
+			report.WorkID,
+			report.FileID,
+			report.StudentID,
+			report.AssignmentID,
+			report.PlagiarismScore,
+			report.IsPlagiarism,
+			report.WordCount,
+			report.AnalysisDurationMs,
+			report.Status,
+			report.ErrorMessage,
+			report.CreatedAt,
+			report.AnalysisMode,
+			pq.Array(report.FileIDs),
+		).Scan(&report.Version)
+
+		var pqErr *pq.Error
+		if !errors.As(err, &pqErr) || pqErr.Code != "23505" || pqErr.Constraint != "uq_reports_file_id_version" {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	report.IsLatest = true
+	return nil
 }
 
 func (r *reportRepository) GetReport(ctx context.Context, reportID string) (*models.Report, error) {
@@ -70,13 +112,18 @@ func (r *reportRepository) GetReport(ctx context.Context, reportID string) (*mod
 		SELECT 
 			report_id, work_id, file_id, student_id, assignment_id,
 			plagiarism_score, is_plagiarism, word_count,
-			analysis_duration_ms, status, error_message, created_at
+			analysis_duration_ms, status, error_message, created_at, analysis_mode,
+			version, ` + isLatestColumn + `, resubmission_of, file_ids
 		FROM reports
 		WHERE report_id = $1
 	`
 
 	row := db.QueryRow(ctx, query, reportID)
-	return r.scanReport(row)
+	report, err := r.scanReport(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrReportNotFound, reportID)
+	}
+	return report, err
 }
 
 func (r *reportRepository) GetReportsByWorkID(ctx context.Context, workID string) ([]*models.Report, error) {
@@ -84,7 +131,8 @@ func (r *reportRepository) GetReportsByWorkID(ctx context.Context, workID string
 		SELECT 
 			report_id, work_id, file_id, student_id, assignment_id,
 			plagiarism_score, is_plagiarism, word_count,
-			analysis_duration_ms, status, error_message, created_at
+			analysis_duration_ms, status, error_message, created_at, analysis_mode,
+			version, ` + isLatestColumn + `, resubmission_of, file_ids
 		FROM reports
 		WHERE work_id = $1
 		ORDER BY created_at DESC
@@ -108,6 +156,36 @@ func (r *reportRepository) GetReportsByWorkID(ctx context.Context, workID string
 	return reports, nil
 }
 
+func (r *reportRepository) GetLatestReportsByAssignment(ctx context.Context, assignmentID string) ([]*models.Report, error) {
+	query := `
+		SELECT DISTINCT ON (file_id)
+			report_id, work_id, file_id, student_id, assignment_id,
+			plagiarism_score, is_plagiarism, word_count,
+			analysis_duration_ms, status, error_message, created_at, analysis_mode,
+			version, TRUE AS is_latest, resubmission_of, file_ids
+		FROM reports
+		WHERE assignment_id = $1
+		ORDER BY file_id, version DESC
+	`
+
+	rows, err := db.Query(ctx, query, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*models.Report
+	for rows.Next() {
+		report, err := r.scanReportFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
 func (r *reportRepository) ListReports(ctx context.Context, params ListReportsParams) ([]*models.Report, int, error) {
 	// Строим динамический запрос
 	whereClauses := []string{}
@@ -154,7 +232,8 @@ func (r *reportRepository) ListReports(ctx context.Context, params ListReportsPa
 		SELECT 
 			report_id, work_id, file_id, student_id, assignment_id,
 			plagiarism_score, is_plagiarism, word_count,
-			analysis_duration_ms, status, error_message, created_at
+			analysis_duration_ms, status, error_message, created_at, analysis_mode,
+			version, `+isLatestColumn+`, resubmission_of, file_ids
 		FROM reports %s
 		ORDER BY created_at DESC
 		LIMIT $%d OFFSET $%d
@@ -188,25 +267,84 @@ func (r *reportRepository) UpdateReportStatus(ctx context.Context, reportID, sta
 	return err
 }
 
+func (r *reportRepository) UpdateReportResult(ctx context.Context, report *models.Report) error {
+	query := `
+		UPDATE reports
+		SET assignment_id = $2, plagiarism_score = $3, is_plagiarism = $4,
+			word_count = $5, analysis_duration_ms = $6, analysis_mode = $7,
+			resubmission_of = $8
+		WHERE report_id = $1
+	`
+	_, err := db.Exec(ctx, query,
+		report.ReportID,
+		report.AssignmentID,
+		report.PlagiarismScore,
+		report.IsPlagiarism,
+		report.WordCount,
+		report.AnalysisDurationMs,
+		report.AnalysisMode,
+		report.ResubmissionOf,
+	)
+	return err
+}
+
 func (r *reportRepository) AddSimilarWork(ctx context.Context, similar *models.SimilarWork) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO similar_works (
-			similar_id, report_id, original_work_id, similar_work_id, similarity_percentage
-		) VALUES ($1, $2, $3, $4, $5)
+			similar_id, report_id, original_work_id, similar_work_id, similarity_percentage,
+			is_self_match, original_path, similar_path
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
-	_, err := db.Exec(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		similar.SimilarID,
 		similar.ReportID,
 		similar.OriginalWorkID,
 		similar.SimilarWorkID,
 		similar.SimilarityPercentage,
+		similar.IsSelfMatch,
+		similar.OriginalPath,
+		similar.SimilarPath,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	fragmentQuery := `
+		INSERT INTO matched_fragments (
+			similar_id, original_offset, original_length, original_start_line, original_end_line,
+			similar_offset, similar_length, similar_start_line, similar_end_line
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	for _, f := range similar.Fragments {
+		_, err := tx.ExecContext(ctx, fragmentQuery,
+			similar.SimilarID,
+			f.OriginalOffset,
+			f.OriginalLength,
+			f.OriginalStartLine,
+			f.OriginalEndLine,
+			f.SimilarOffset,
+			f.SimilarLength,
+			f.SimilarStartLine,
+			f.SimilarEndLine,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add matched fragment: %w", err)
+		}
+	}
+
+	return tx.Commit()
 }
 
 func (r *reportRepository) GetSimilarWorks(ctx context.Context, reportID string) ([]models.SimilarWork, error) {
 	query := `
-		SELECT similar_id, report_id, original_work_id, similar_work_id, similarity_percentage
+		SELECT similar_id, report_id, original_work_id, similar_work_id, similarity_percentage,
+			COALESCE(is_self_match, FALSE), original_path, similar_path
 		FROM similar_works
 		WHERE report_id = $1
 		ORDER BY similarity_percentage DESC
@@ -227,22 +365,125 @@ func (r *reportRepository) GetSimilarWorks(ctx context.Context, reportID string)
 			&sw.OriginalWorkID,
 			&sw.SimilarWorkID,
 			&sw.SimilarityPercentage,
+			&sw.IsSelfMatch,
+			&sw.OriginalPath,
+			&sw.SimilarPath,
 		)
 		if err != nil {
 			return nil, err
 		}
 		similarWorks = append(similarWorks, sw)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	fragments, err := r.getMatchedFragments(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range similarWorks {
+		similarWorks[i].Fragments = fragments[similarWorks[i].SimilarID]
+	}
 
 	return similarWorks, nil
 }
 
-func (r *reportRepository) DeleteReport(ctx context.Context, reportID string) error {
-	query := "DELETE FROM reports WHERE report_id = $1"
-	_, err := db.Exec(ctx, query, reportID)
+// getMatchedFragments возвращает совпавшие фрагменты всех похожих работ отчета,
+// сгруппированные по similar_id
+func (r *reportRepository) getMatchedFragments(ctx context.Context, reportID string) (map[string][]models.MatchedFragment, error) {
+	query := `
+		SELECT
+			f.similar_id, f.original_offset, f.original_length, f.original_start_line, f.original_end_line,
+			f.similar_offset, f.similar_length, f.similar_start_line, f.similar_end_line
+		FROM matched_fragments f
+		JOIN similar_works sw ON sw.similar_id = f.similar_id
+		WHERE sw.report_id = $1
+		ORDER BY f.similar_id, f.original_offset
+	`
+
+	rows, err := db.Query(ctx, query, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fragments := make(map[string][]models.MatchedFragment)
+	for rows.Next() {
+		var f models.MatchedFragment
+		err := rows.Scan(
+			&f.SimilarID,
+			&f.OriginalOffset,
+			&f.OriginalLength,
+			&f.OriginalStartLine,
+			&f.OriginalEndLine,
+			&f.SimilarOffset,
+			&f.SimilarLength,
+			&f.SimilarStartLine,
+			&f.SimilarEndLine,
+		)
+		if err != nil {
+			return nil, err
+		}
+		fragments[f.SimilarID] = append(fragments[f.SimilarID], f)
+	}
+
+	return fragments, rows.Err()
+}
+
+func (r *reportRepository) DeleteSimilarWorks(ctx context.Context, reportID string) error {
+	_, err := db.Exec(ctx, "DELETE FROM similar_works WHERE report_id = $1", reportID)
 	return err
 }
 
+// DeleteReport удаляет отчет и перестраивает индекс отпечатков: отпечатки
+// каждого файла отчета (в том числе файлов из архива и остальных файлов
+// работы) переходят к последнему оставшемуся завершенному отчету по этому
+// файлу, а если таких нет, удаляются из индекса
+func (r *reportRepository) DeleteReport(ctx context.Context, reportID string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Файл охватывает отчет, у которого он основной или входит в file_ids, а
+	// также другая версия того же отчета: она проверяет те же файлы архива
+	reassignQuery := `
+		UPDATE fingerprints f
+		SET report_id = latest.report_id
+		FROM (
+			SELECT DISTINCT ON (indexed.file_id) indexed.file_id, r.report_id
+			FROM (SELECT DISTINCT file_id FROM fingerprints WHERE report_id = $1) indexed
+			JOIN reports deleted ON deleted.report_id = $1
+			JOIN reports r ON r.report_id != $1 AND r.status = 'completed' AND (
+				r.file_id = indexed.file_id
+				OR indexed.file_id = ANY(r.file_ids)
+				OR r.file_id = deleted.file_id
+			)
+			ORDER BY indexed.file_id, r.created_at DESC
+		) latest
+		WHERE f.report_id = $1 AND f.file_id = latest.file_id
+	`
+	if _, err := tx.ExecContext(ctx, reassignQuery, reportID); err != nil {
+		return fmt.Errorf("failed to reassign fingerprints: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM fingerprints WHERE report_id = $1", reportID); err != nil {
+		return fmt.Errorf("failed to delete fingerprints: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM analysis_jobs WHERE report_id = $1", reportID); err != nil {
+		return fmt.Errorf("failed to delete analysis job: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM reports WHERE report_id = $1", reportID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *reportRepository) scanReport(row *sql.Row) (*models.Report, error) {
 	var report models.Report
 	err := row.Scan(
@@ -258,6 +499,11 @@ func (r *reportRepository) scanReport(row *sql.Row) (*models.Report, error) {
 		&report.Status,
 		&report.ErrorMessage,
 		&report.CreatedAt,
+		&report.AnalysisMode,
+		&report.Version,
+		&report.IsLatest,
+		&report.ResubmissionOf,
+		pq.Array(&report.FileIDs),
 	)
 	if err != nil {
 		return nil, err
@@ -280,6 +526,11 @@ func (r *reportRepository) scanReportFromRows(rows *sql.Rows) (*models.Report, e
 		&report.Status,
 		&report.ErrorMessage,
 		&report.CreatedAt,
+		&report.AnalysisMode,
+		&report.Version,
+		&report.IsLatest,
+		&report.ResubmissionOf,
+		pq.Array(&report.FileIDs),
 	)
 	if err != nil {
 		return nil, err
@@ -5,125 +5,514 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/internal/file-analysis/extractor"
 	"sd_hw3/internal/file-analysis/models"
 	"sd_hw3/internal/file-analysis/repository"
+	"sd_hw3/internal/file-analysis/similarity"
+	"sd_hw3/pkg/apiclient"
 	"sd_hw3/pkg/config"
 )
 
+// FileStorageClient запросы к file-storage; реализуется apiclient.FileStorage
 type FileStorageClient interface {
 	GetFileContent(ctx context.Context, fileID string) ([]byte, error)
-	GetFileMetadata(ctx context.Context, fileID string) (map[string]interface{}, error)
-	GetSimilarWorks(ctx context.Context, fileID string) ([]string, error)
+	GetFileMetadata(ctx context.Context, fileID string) (*filestorage.FileMetadata, error)
+	// ListTemplates возвращает шаблонные файлы задания
+	ListTemplates(ctx context.Context, assignmentID string) ([]filestorage.FileMetadata, error)
+	// ListArchiveMembers возвращает файлы, распакованные из архива
+	ListArchiveMembers(ctx context.Context, fileID string) ([]filestorage.FileMetadata, error)
 }
 
 type AnalysisService interface {
 	AnalyzeFile(ctx context.Context, req *models.AnalysisRequest) (*models.Report, error)
+	ReanalyzeReport(ctx context.Context, reportID string) (*models.Report, error)
+	ReanalyzeAssignment(ctx context.Context, assignmentID string) ([]*models.Report, error)
+	ProcessReport(ctx context.Context, reportID string) error
+	FailReport(ctx context.Context, reportID, errMsg string) error
 	GetReport(ctx context.Context, reportID string) (*models.Report, error)
 	GetWorkReports(ctx context.Context, workID string) ([]*models.Report, error)
 	ListReports(ctx context.Context, params repository.ListReportsParams) ([]*models.Report, int, error)
+	GetComparison(ctx context.Context, reportID, similarID string) (*models.Comparison, error)
+	// DeleteReport удаляет завершенный отчет; его отпечатки переходят к
+	// последнему оставшемуся отчету по тем же файлам
+	DeleteReport(ctx context.Context, reportID string) error
 }
 
+var (
+	// ErrNotFound запрошенный отчет или похожая работа не найдены
+	ErrNotFound = errors.New("not found")
+	// ErrReportInProgress отчет в очереди или в обработке
+	ErrReportInProgress = errors.New("report analysis is in progress")
+)
+
+// maxCandidates сколько работ, отобранных по индексу отпечатков, сравнивается полностью
+const maxCandidates = 50
+
+// Режимы анализа работ, файлы которых сравниваются по отдельности
+const (
+	archiveAnalysisMode    = "archive"
+	submissionAnalysisMode = "multi-file"
+)
+
 type analysisService struct {
 	config            config.Config
 	repo              repository.ReportRepository
+	fingerprintRepo   repository.FingerprintRepository
+	extractedTextRepo repository.ExtractedTextRepository
+	jobRepo           repository.JobRepository
 	fileStorageClient FileStorageClient
-	cache             map[string]*models.Report // простой in-memory кэш
+	extractors        *extractor.Registry
+	policies          PolicyService
+	cacheMu           sync.RWMutex
+	cache             map[string]*models.Report // простой in-memory кэш завершенных отчетов
 }
 
-func NewAnalysisService(cfg config.Config, repo repository.ReportRepository) AnalysisService {
+func NewAnalysisService(cfg config.Config, repo repository.ReportRepository, fingerprintRepo repository.FingerprintRepository, extractedTextRepo repository.ExtractedTextRepository, jobRepo repository.JobRepository, policies PolicyService, fileStorageClient FileStorageClient) AnalysisService {
 	return &analysisService{
-		config: cfg,
-		repo:   repo,
-		fileStorageClient: &httpFileStorageClient{
-			baseURL: cfg.FileStorageURL,
-		},
-		cache: make(map[string]*models.Report),
+		config:            cfg,
+		repo:              repo,
+		fingerprintRepo:   fingerprintRepo,
+		extractedTextRepo: extractedTextRepo,
+		jobRepo:           jobRepo,
+		fileStorageClient: fileStorageClient,
+		extractors:        extractor.NewRegistry(),
+		policies:          policies,
+		cache:             make(map[string]*models.Report),
 	}
 }
 
+// AnalyzeFile создает отчет в статусе pending и ставит файл в очередь анализа.
+// Сам анализ выполняет WorkerPool через ProcessReport. Если в работе несколько
+// файлов (req.FileIDs), один отчет охватывает их все.
 func (s *analysisService) AnalyzeFile(ctx context.Context, req *models.AnalysisRequest) (*models.Report, error) {
-	startTime := time.Now()
-
-	reportID := generateReportID(req.FileID)
-
-	if cached, ok := s.cache[reportID]; ok && s.config.EnableCaching {
-		return cached, nil
-	}
-
 	report := &models.Report{
-		ReportID:     reportID,
+		ReportID:     generateReportID(req.FileID),
 		WorkID:       req.WorkID,
 		FileID:       req.FileID,
+		FileIDs:      submissionFileIDs(req.FileID, req.FileIDs),
 		StudentID:    getStringValue(req.StudentID),
 		AssignmentID: getStringValue(req.AssignmentID),
-		Status:       "completed",
+		Status:       models.ReportStatusPending,
+		AnalysisMode: similarity.TextTokenizer().Mode(),
 		CreatedAt:    time.Now(),
 	}
 
+	if err := s.repo.CreateReport(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to save report: %w", err)
+	}
+
+	if err := s.jobRepo.EnqueueJob(ctx, report.ReportID); err != nil {
+		report.Status = models.ReportStatusFailed
+		errMsg := fmt.Sprintf("Failed to queue analysis: %v", err)
+		report.ErrorMessage = &errMsg
+		s.repo.UpdateReportStatus(ctx, report.ReportID, report.Status, report.ErrorMessage)
+		return report, fmt.Errorf("failed to queue analysis: %w", err)
+	}
+
+	return report, nil
+}
+
+// ReanalyzeReport ставит в очередь повторный анализ файла отчета с текущим
+// корпусом работ. Создается новая версия отчета, старая остается в истории.
+func (s *analysisService) ReanalyzeReport(ctx context.Context, reportID string) (*models.Report, error) {
+	report, err := s.repo.GetReport(ctx, reportID)
+	if errors.Is(err, repository.ErrReportNotFound) {
+		return nil, fmt.Errorf("%w: report %s", ErrNotFound, reportID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return s.reanalyze(ctx, report)
+}
+
+// DeleteReport удаляет отчет и перестраивает индекс отпечатков. Отчет в
+// очереди или в обработке удалить нельзя: анализ записал бы его отпечатки
+// в индекс уже после удаления.
+func (s *analysisService) DeleteReport(ctx context.Context, reportID string) error {
+	report, err := s.repo.GetReport(ctx, reportID)
+	if errors.Is(err, repository.ErrReportNotFound) {
+		return fmt.Errorf("%w: report %s", ErrNotFound, reportID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+	if report.Status == models.ReportStatusPending || report.Status == models.ReportStatusProcessing {
+		return fmt.Errorf("%w: report %s is %s", ErrReportInProgress, reportID, report.Status)
+	}
+
+	if err := s.repo.DeleteReport(ctx, reportID); err != nil {
+		return fmt.Errorf("failed to delete report: %w", err)
+	}
+	// У оставшихся версий отчета по файлу меняется признак последней версии
+	s.evictFileReports(report.FileID)
+	return nil
+}
+
+// ReanalyzeAssignment ставит в очередь повторный анализ всех файлов задания
+func (s *analysisService) ReanalyzeAssignment(ctx context.Context, assignmentID string) ([]*models.Report, error) {
+	latest, err := s.repo.GetLatestReportsByAssignment(ctx, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reports: %w", err)
+	}
+	if len(latest) == 0 {
+		return nil, fmt.Errorf("%w: reports for assignment %s", ErrNotFound, assignmentID)
+	}
+
+	reports := make([]*models.Report, 0, len(latest))
+	for _, report := range latest {
+		queued, err := s.reanalyze(ctx, report)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, queued)
+	}
+	return reports, nil
+}
+
+// submissionFileIDs список файлов работы, начиная с fileID, без повторов;
+// nil, если файл в работе один
+func submissionFileIDs(fileID string, fileIDs []string) []string {
+	ids := []string{fileID}
+	for _, id := range fileIDs {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 1 {
+		return nil
+	}
+	return ids
+}
+
+func (s *analysisService) reanalyze(ctx context.Context, report *models.Report) (*models.Report, error) {
+	// Предыдущие версии в кэше перестают быть последними
+	s.evictFileReports(report.FileID)
+
+	return s.AnalyzeFile(ctx, &models.AnalysisRequest{
+		WorkID:       report.WorkID,
+		FileID:       report.FileID,
+		FileIDs:      report.FileIDs,
+		StudentID:    &report.StudentID,
+		AssignmentID: &report.AssignmentID,
+	})
+}
+
+// ProcessReport выполняет анализ для отчета из очереди, переводя его
+// в processing, а затем в completed или failed
+func (s *analysisService) ProcessReport(ctx context.Context, reportID string) error {
+	report, err := s.repo.GetReport(ctx, reportID)
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+
+	// Отчет уже обработан: задание могло быть взято повторно после сбоя
+	if report.Status == models.ReportStatusCompleted || report.Status == models.ReportStatusFailed {
+		return nil
+	}
+
+	if err := s.repo.UpdateReportStatus(ctx, reportID, models.ReportStatusProcessing, nil); err != nil {
+		return fmt.Errorf("failed to update report status: %w", err)
+	}
+
+	// Прерванный анализ мог успеть сохранить часть похожих работ
+	if err := s.repo.DeleteSimilarWorks(ctx, reportID); err != nil {
+		return fmt.Errorf("failed to clear similar works: %w", err)
+	}
+
+	if err := s.analyze(ctx, report); err != nil {
+		// Пока file-storage недоступен, отчет снова ждет в очереди: задание
+		// повторит WorkerPool
+		status, errMsg := models.ReportStatusFailed, optionalString(err.Error())
+		if errors.Is(err, apiclient.ErrUnavailable) {
+			status, errMsg = models.ReportStatusPending, nil
+		}
+		if err := s.repo.UpdateReportStatus(ctx, reportID, status, errMsg); err != nil {
+			fmt.Printf("Failed to update report status: %v\n", err)
+		}
+		return err
+	}
+
+	if err := s.repo.UpdateReportResult(ctx, report); err != nil {
+		return fmt.Errorf("failed to save analysis result: %w", err)
+	}
+
+	return s.repo.UpdateReportStatus(ctx, reportID, models.ReportStatusCompleted, nil)
+}
+
+// FailReport помечает отчет как failed, если его анализ не может быть выполнен
+func (s *analysisService) FailReport(ctx context.Context, reportID, errMsg string) error {
+	return s.repo.UpdateReportStatus(ctx, reportID, models.ReportStatusFailed, &errMsg)
+}
+
+// analyze сравнивает файл отчета с работами по тому же заданию и
+// заполняет результаты анализа в report. Архив и работа из нескольких файлов
+// сравниваются пофайлово.
+func (s *analysisService) analyze(ctx context.Context, report *models.Report) error {
+	startTime := time.Now()
 	defer func() {
 		report.AnalysisDurationMs = int(time.Since(startTime).Milliseconds())
 	}()
 
-	fileContent, err := s.fileStorageClient.GetFileContent(ctx, req.FileID)
+	// Без метаданных файл разбирался бы как обычный текст без задания, поэтому
+	// при недоступности file-storage анализ откладывается
+	info, err := s.fetchFileInfo(ctx, report.FileID)
+	if errors.Is(err, apiclient.ErrUnavailable) {
+		return err
+	}
 	if err != nil {
-		report.Status = "failed"
-		errMsg := fmt.Sprintf("Failed to get file from storage: %v", err)
-		report.ErrorMessage = &errMsg
-		s.repo.CreateReport(ctx, report)
-		return report, fmt.Errorf("failed to get file content: %w", err)
+		fmt.Printf("Failed to get file metadata: %v\n", err)
+	}
+	if report.AssignmentID == "" {
+		report.AssignmentID = info.AssignmentID
+	}
+	// Владелец работы берется из file-storage: student_id в запросе на анализ необязателен
+	if info.StudentID == "" {
+		info.StudentID = report.StudentID
+	}
+	if report.StudentID == "" {
+		report.StudentID = info.StudentID
 	}
 
-	if int64(len(fileContent)) > s.config.MaxUploadSize {
-		report.Status = "failed"
-		errMsg := fmt.Sprintf("File too large: %d bytes (max: %d)", len(fileContent), s.config.MaxUploadSize)
-		report.ErrorMessage = &errMsg
-		s.repo.CreateReport(ctx, report)
-		return report, fmt.Errorf("file too large")
+	policy := s.policies.EffectivePolicy(ctx, report.AssignmentID)
+	engine, err := similarity.NewEngine(policy.Algorithm, policy.MinMatchLength)
+	if err != nil {
+		return fmt.Errorf("invalid policy for assignment %s: %w", report.AssignmentID, err)
+	}
+
+	var templates []similarity.Document
+	if policy.IgnoreTemplateCode {
+		templates, err = s.loadTemplates(ctx, report.AssignmentID)
+		if err != nil {
+			return err
+		}
 	}
 
-	text := string(fileContent)
+	if len(report.FileIDs) > 0 {
+		return s.analyzeSubmission(ctx, report, info, engine, policy, templates)
+	}
+	if info.IsArchive {
+		return s.analyzeArchive(ctx, report, info, engine, policy, templates)
+	}
 
-	report.WordCount = countWords(text)
+	result, err := s.analyzeDocument(ctx, report, info, engine, policy, templates, nil)
+	if err != nil {
+		return err
+	}
 
-	report.PlagiarismScore = calculatePlagiarismScore(text)
+	report.WordCount = result.words
+	report.AnalysisMode = result.mode
+	report.PlagiarismScore = result.score
+	report.IsPlagiarism = similarity.IsPlagiarism(report.PlagiarismScore, policy.PlagiarismThreshold)
+	report.ResubmissionOf = result.resubmissionOf
 
-	report.IsPlagiarism = report.PlagiarismScore > s.config.PlagiarismThreshold
+	return nil
+}
+
+// analyzeSubmission сравнивает с работами задания каждый файл работы из
+// нескольких файлов; архивы среди них сравниваются по файлам из них
+func (s *analysisService) analyzeSubmission(ctx context.Context, report *models.Report, info fileInfo, engine similarity.Engine, policy *models.Policy, templates []similarity.Document) error {
+	var files []fileInfo
+	for _, id := range report.FileIDs {
+		file := info
+		if id != info.FileID {
+			fetched, err := s.getFileInfo(ctx, id)
+			if err != nil {
+				return err
+			}
+			file = fetched
+		}
+		if !file.IsArchive {
+			files = append(files, file)
+			continue
+		}
 
-	if report.PlagiarismScore > 50 {
-		similarWorks, err := s.fileStorageClient.GetSimilarWorks(ctx, req.FileID)
+		members, err := s.getArchiveMembers(ctx, file.FileID)
 		if err != nil {
-			fmt.Printf("Failed to get similar works: %v\n", err)
+			return err
 		}
-		for _, similar := range similarWorks {
-			if err := s.repo.AddSimilarWork(ctx, models.MapFileIDToSimilarWorks(req.FileID, similar, reportID)); err != nil {
-				fmt.Printf("Failed to add similar work: %v\n", err)
-			}
+		files = append(files, members...)
+	}
+
+	return s.analyzeFiles(ctx, report, info.StudentID, files, submissionAnalysisMode, engine, policy, templates)
+}
+
+// analyzeArchive сравнивает с работами задания каждый файл архива
+func (s *analysisService) analyzeArchive(ctx context.Context, report *models.Report, info fileInfo, engine similarity.Engine, policy *models.Policy, templates []similarity.Document) error {
+	members, err := s.getArchiveMembers(ctx, info.FileID)
+	if err != nil {
+		return err
+	}
+
+	return s.analyzeFiles(ctx, report, info.StudentID, members, archiveAnalysisMode, engine, policy, templates)
+}
+
+// getArchiveMembers получает сведения о файлах из архива
+func (s *analysisService) getArchiveMembers(ctx context.Context, fileID string) ([]fileInfo, error) {
+	metadata, err := s.fileStorageClient.ListArchiveMembers(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive members: %w", err)
+	}
+
+	members := make([]fileInfo, len(metadata))
+	for i := range metadata {
+		members[i] = mapFileInfo(&metadata[i])
+	}
+	return members, nil
+}
+
+// analyzeFiles сравнивает с работами задания каждый из файлов работы студента
+// studentID. Оценка работы - среднее оценок файлов, взвешенное числом слов в них.
+func (s *analysisService) analyzeFiles(ctx context.Context, report *models.Report, studentID string, files []fileInfo, mode string, engine similarity.Engine, policy *models.Policy, templates []similarity.Document) error {
+	fileIDs := make([]string, len(files))
+	for i, file := range files {
+		fileIDs[i] = file.FileID
+	}
+
+	var weightedScore float64
+	var lastErr error
+	analyzed := 0
+	report.WordCount = 0
+	report.ResubmissionOf = nil
+	for _, file := range files {
+		file.StudentID = studentID
+		// Файлы одной работы не сравниваются друг с другом
+		result, err := s.analyzeDocument(ctx, report, file, engine, policy, templates, fileIDs)
+		if errors.Is(err, apiclient.ErrUnavailable) {
+			// Без недоступных файлов оценка работы была бы неверной
+			return err
+		}
+		if err != nil {
+			// Файл, который не удалось разобрать, не мешает проверке остальных
+			fmt.Printf("Failed to analyze file %s of report %s: %v\n", file.FileID, report.ReportID, err)
+			lastErr = err
+			continue
+		}
+		analyzed++
+		report.WordCount += result.words
+		weightedScore += float64(result.score) * float64(result.words)
+		if report.ResubmissionOf == nil {
+			report.ResubmissionOf = result.resubmissionOf
 		}
 	}
+	if analyzed == 0 && len(files) > 0 {
+		return fmt.Errorf("failed to analyze any of %d files: %w", len(files), lastErr)
+	}
 
-	if err := s.repo.CreateReport(ctx, report); err != nil {
-		return nil, fmt.Errorf("failed to save report: %w", err)
+	report.AnalysisMode = mode
+	report.PlagiarismScore = 0
+	if report.WordCount > 0 {
+		report.PlagiarismScore = float32(weightedScore / float64(report.WordCount))
+	}
+	report.IsPlagiarism = similarity.IsPlagiarism(report.PlagiarismScore, policy.PlagiarismThreshold)
+
+	return nil
+}
+
+// documentResult результат сравнения одного файла
+type documentResult struct {
+	words          int
+	mode           string
+	score          float32
+	resubmissionOf *string
+}
+
+// analyzeDocument сравнивает файл с работами по заданию отчета, сохраняет его
+// отпечатки в индекс и похожие работы в report. Файлы exclude похожими
+// работами не считаются.
+func (s *analysisService) analyzeDocument(ctx context.Context, report *models.Report, info fileInfo, engine similarity.Engine, policy *models.Policy, templates []similarity.Document, exclude []string) (*documentResult, error) {
+	text, err := s.loadText(ctx, info.FileID, &info)
+	if err != nil {
+		return nil, err
 	}
 
-	if s.config.EnableCaching {
-		s.cache[reportID] = report
+	// Режим анализа (обычный текст или исходный код) определяется по файлу
+	tokenizer := similarity.TokenizerFor(info.Filename, info.ContentType)
+
+	// Индекс отпечатков строится с параметрами по умолчанию независимо от политики,
+	// чтобы отпечатки работ разных заданий и версий оставались сопоставимыми
+	fingerprints := similarity.Winnow(tokenizer.Tokenize(text), similarity.DefaultShingleSize, similarity.DefaultWindowSize)
+
+	corpus, own, infos, err := s.loadCorpus(ctx, report.AssignmentID, info.FileID, info.StudentID, fingerprints, policy.CompareAllRevisions)
+	if err != nil {
+		return nil, err
 	}
 
-	return report, nil
+	// Шаблоны не считаются чужими работами
+	skip := func(doc similarity.Document) bool {
+		return slices.Contains(exclude, doc.ID) ||
+			slices.ContainsFunc(templates, func(t similarity.Document) bool { return t.ID == doc.ID })
+	}
+	corpus = slices.DeleteFunc(corpus, skip)
+	own = slices.DeleteFunc(own, skip)
+
+	submission := similarity.Document{ID: info.FileID, Text: text}
+	result := engine.Compare(submission, corpus, templates, tokenizer)
+
+	// Совпадения с собственными более ранними работами студента - это повторная
+	// загрузка, а не заимствование: в оценку они не входят, но попадают в отчет
+	var selfMatches []similarity.Match
+	if len(own) > 0 {
+		selfMatches = engine.Compare(submission, own, templates, tokenizer).Matches
+	}
+
+	document := &documentResult{
+		words: countWords(text),
+		mode:  tokenizer.Mode(),
+		score: result.PlagiarismScore,
+	}
+	if len(selfMatches) > 0 {
+		// Совпадение с файлом из архива - повторная загрузка всего архива
+		resubmissionOf := selfMatches[0].DocumentID
+		if parent := infos[resubmissionOf].ParentFileID; parent != "" {
+			resubmissionOf = parent
+		}
+		document.resubmissionOf = &resubmissionOf
+	}
+
+	s.indexFingerprints(ctx, report, info.FileID, fingerprints)
+
+	s.addSimilarWorks(ctx, report, info, infos, result.Matches, false, policy.MinSimilarityPercentage)
+	s.addSimilarWorks(ctx, report, info, infos, selfMatches, true, policy.MinSimilarityPercentage)
+
+	return document, nil
+}
+
+// addSimilarWorks сохраняет в отчет похожие на файл original работы со
+// сходством не ниже minSimilarity. Для файлов из архивов сохраняются их пути.
+func (s *analysisService) addSimilarWorks(ctx context.Context, report *models.Report, original fileInfo, infos map[string]fileInfo, matches []similarity.Match, selfMatch bool, minSimilarity float32) {
+	for _, match := range matches {
+		if match.SimilarityPercentage < minSimilarity {
+			continue
+		}
+		similar := models.MapFileIDToSimilarWorks(original.FileID, match.DocumentID, report.ReportID, match.SimilarityPercentage)
+		similar.SimilarID = generateSimilarID(report.ReportID, original.FileID, match.DocumentID)
+		similar.IsSelfMatch = selfMatch
+		similar.OriginalPath = optionalString(original.MemberPath)
+		similar.SimilarPath = optionalString(infos[match.DocumentID].MemberPath)
+		similar.Fragments = models.MapFragments(similar.SimilarID, match.Fragments)
+		if err := s.repo.AddSimilarWork(ctx, similar); err != nil {
+			fmt.Printf("Failed to add similar work: %v\n", err)
+			continue
+		}
+		report.SimilarWorks = append(report.SimilarWorks, *similar)
+	}
 }
 
 func (s *analysisService) GetReport(ctx context.Context, reportID string) (*models.Report, error) {
 	// Проверяем кэш
-	if cached, ok := s.cache[reportID]; ok && s.config.EnableCaching {
+	if cached, ok := s.cachedReport(reportID); ok {
 		return cached, nil
 	}
 
@@ -139,13 +528,46 @@ func (s *analysisService) GetReport(ctx context.Context, reportID string) (*mode
 		fmt.Printf("Failed to get similar works: %v\n", err)
 	}
 	report.SimilarWorks = similarWorks
-	if s.config.EnableCaching {
-		s.cache[reportID] = report
-	}
+	s.cacheReport(report)
 
 	return report, nil
 }
 
+func (s *analysisService) cachedReport(reportID string) (*models.Report, bool) {
+	if !s.config.EnableCaching {
+		return nil, false
+	}
+	s.cacheMu.RLock()
+	defer s.cacheMu.RUnlock()
+	report, ok := s.cache[reportID]
+	return report, ok
+}
+
+// evictFileReports удаляет из кэша все отчеты по файлу
+func (s *analysisService) evictFileReports(fileID string) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	for id, report := range s.cache {
+		if report.FileID == fileID {
+			delete(s.cache, id)
+		}
+	}
+}
+
+// cacheReport кэширует отчет, если его анализ завершен: отчеты в очереди
+// и в обработке еще будут меняться
+func (s *analysisService) cacheReport(report *models.Report) {
+	if !s.config.EnableCaching {
+		return
+	}
+	if report.Status != models.ReportStatusCompleted && report.Status != models.ReportStatusFailed {
+		return
+	}
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	s.cache[report.ReportID] = report
+}
+
 func (s *analysisService) GetWorkReports(ctx context.Context, workID string) ([]*models.Report, error) {
 	return s.repo.GetReportsByWorkID(ctx, workID)
 }
@@ -154,12 +576,270 @@ func (s *analysisService) ListReports(ctx context.Context, params repository.Lis
 	return s.repo.ListReports(ctx, params)
 }
 
+// GetComparison собирает содержимое анализируемого файла и похожего файла
+// вместе с совпавшими фрагментами для их сравнения
+func (s *analysisService) GetComparison(ctx context.Context, reportID, similarID string) (*models.Comparison, error) {
+	report, err := s.GetReport(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: report %s", ErrNotFound, reportID)
+	}
+
+	idx := slices.IndexFunc(report.SimilarWorks, func(sw models.SimilarWork) bool {
+		return sw.SimilarID == similarID
+	})
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: similar work %s in report %s", ErrNotFound, similarID, reportID)
+	}
+	similar := report.SimilarWorks[idx]
+
+	// Смещения фрагментов относятся к извлеченному тексту, а не к исходным байтам
+	// файла. У архива сравнивается файл из него, а не сам архив.
+	original, err := s.loadText(ctx, similar.OriginalWorkID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get text of file %s: %w", similar.OriginalWorkID, err)
+	}
+	matched, err := s.loadText(ctx, similar.SimilarWorkID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get text of file %s: %w", similar.SimilarWorkID, err)
+	}
+
+	return &models.Comparison{
+		Report:       report,
+		SimilarWork:  similar,
+		OriginalText: original,
+		SimilarText:  matched,
+	}, nil
+}
+
+// fileInfo сведения о файле из file-storage, нужные для анализа
+type fileInfo struct {
+	FileID       string
+	StudentID    string
+	AssignmentID string
+	Filename     string
+	ContentType  string
+	IsArchive    bool
+	ParentFileID string
+	MemberPath   string
+	// OutdatedRevision файл не относится к текущей ревизии своей работы
+	OutdatedRevision bool
+}
+
+// getFileInfo получает метаданные файла из file-storage. Если их не удалось
+// получить, возвращаются пустые значения и файл анализируется как обычный
+// текст; ошибка возвращается, только если file-storage недоступен.
+func (s *analysisService) getFileInfo(ctx context.Context, fileID string) (fileInfo, error) {
+	info, err := s.fetchFileInfo(ctx, fileID)
+	if errors.Is(err, apiclient.ErrUnavailable) {
+		return info, err
+	}
+	if err != nil {
+		fmt.Printf("Failed to get file metadata: %v\n", err)
+	}
+	return info, nil
+}
+
+// fetchFileInfo получает метаданные файла из file-storage; при ошибке вместе
+// с ней возвращаются пустые значения
+func (s *analysisService) fetchFileInfo(ctx context.Context, fileID string) (fileInfo, error) {
+	metadata, err := s.fileStorageClient.GetFileMetadata(ctx, fileID)
+	if err != nil {
+		return fileInfo{FileID: fileID}, err
+	}
+
+	info := mapFileInfo(metadata)
+	info.FileID = fileID
+	return info, nil
+}
+
+// mapFileInfo переводит метаданные файла из ответа file-storage
+func mapFileInfo(metadata *filestorage.FileMetadata) fileInfo {
+	info := fileInfo{
+		FileID:       apiclient.Value(metadata.FileId),
+		StudentID:    apiclient.Value(metadata.StudentId),
+		AssignmentID: apiclient.Value(metadata.AssignmentId),
+		Filename:     apiclient.Value(metadata.Filename),
+		ContentType:  apiclient.Value(metadata.ContentType),
+		IsArchive:    apiclient.Value(metadata.IsArchive),
+		ParentFileID: apiclient.Value(metadata.ParentFileId),
+		MemberPath:   apiclient.Value(metadata.MemberPath),
+	}
+	if metadata.IsCurrentRevision != nil {
+		info.OutdatedRevision = !*metadata.IsCurrentRevision
+	}
+	return info
+}
+
+// loadText возвращает простой текст файла. Текст, извлеченный из документов
+// (PDF, DOCX и т.д.), сохраняется в БД и при повторном анализе не разбирается
+// заново. Если info равен nil, метаданные файла запрашиваются при необходимости.
+func (s *analysisService) loadText(ctx context.Context, fileID string, info *fileInfo) (string, error) {
+	saved, err := s.extractedTextRepo.GetExtractedText(ctx, fileID)
+	if err != nil {
+		fmt.Printf("Failed to get extracted text: %v\n", err)
+	} else if saved != nil {
+		return saved.Text, nil
+	}
+
+	if info == nil {
+		fetched, err := s.getFileInfo(ctx, fileID)
+		if err != nil {
+			return "", err
+		}
+		info = &fetched
+	}
+
+	content, err := s.fileStorageClient.GetFileContent(ctx, fileID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get file from storage: %w", err)
+	}
+	if int64(len(content)) > s.config.MaxUploadSize {
+		return "", fmt.Errorf("file too large: %d bytes (max: %d)", len(content), s.config.MaxUploadSize)
+	}
+
+	ext := s.extractors.For(info.Filename, info.ContentType)
+	text, err := ext.Extract(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract text (%s): %w", ext.Name(), err)
+	}
+
+	// Простой текст не требует разбора, хранить его копию незачем
+	if ext.Name() != extractor.PlainTextName {
+		err := s.extractedTextRepo.SaveExtractedText(ctx, &models.ExtractedText{
+			FileID:    fileID,
+			Extractor: ext.Name(),
+			Text:      text,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			fmt.Printf("Failed to save extracted text: %v\n", err)
+		}
+	}
+
+	return text, nil
+}
+
+// loadCorpus загружает работы по тому же заданию, у которых в индексе
+// есть общие с анализируемой работой отпечатки. Работы других студентов
+// возвращаются в corpus, работы самого студента studentID - в own, а
+// сведения о файлах всех работ - в infos. Прежние ревизии работ других
+// студентов пропускаются, если allRevisions не задан. Если file-storage
+// недоступен, возвращается apiclient.ErrUnavailable: сравнение с неполным
+// набором работ занизило бы оценку.
+func (s *analysisService) loadCorpus(ctx context.Context, assignmentID, fileID, studentID string, fingerprints []similarity.Fingerprint, allRevisions bool) (corpus, own []similarity.Document, infos map[string]fileInfo, err error) {
+	if assignmentID == "" {
+		return nil, nil, nil, nil
+	}
+
+	hashes := make([]int64, len(fingerprints))
+	for i, fp := range fingerprints {
+		hashes[i] = int64(fp.Hash)
+	}
+
+	fileIDs, err := s.fingerprintRepo.FindCandidates(ctx, assignmentID, fileID, hashes, maxCandidates)
+	if err != nil {
+		fmt.Printf("Failed to find candidates: %v\n", err)
+		return nil, nil, nil, nil
+	}
+
+	infos = make(map[string]fileInfo, len(fileIDs))
+	for _, id := range fileIDs {
+		info, err := s.getFileInfo(ctx, id)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		infos[id] = info
+		// Прежние ревизии чужих работ по умолчанию не сравниваются: студент
+		// мог их заменить. Свои прежние ревизии нужны для поиска повторных загрузок.
+		isOwn := studentID != "" && info.StudentID == studentID
+		if !isOwn && info.OutdatedRevision && !allRevisions {
+			continue
+		}
+		text, err := s.loadText(ctx, id, &info)
+		if errors.Is(err, apiclient.ErrUnavailable) {
+			return nil, nil, nil, err
+		}
+		if err != nil {
+			fmt.Printf("Failed to get text of file %s: %v\n", id, err)
+			continue
+		}
+		doc := similarity.Document{ID: id, Text: text}
+		if isOwn {
+			own = append(own, doc)
+		} else {
+			corpus = append(corpus, doc)
+		}
+	}
+	return corpus, own, infos, nil
+}
+
+// loadTemplates загружает шаблонные файлы задания, совпадения с которыми
+// не считаются заимствованием. Если file-storage недоступен, возвращается
+// apiclient.ErrUnavailable: без шаблонов оценка была бы завышена.
+func (s *analysisService) loadTemplates(ctx context.Context, assignmentID string) ([]similarity.Document, error) {
+	if assignmentID == "" {
+		return nil, nil
+	}
+
+	files, err := s.fileStorageClient.ListTemplates(ctx, assignmentID)
+	if errors.Is(err, apiclient.ErrUnavailable) {
+		return nil, err
+	}
+	if err != nil {
+		fmt.Printf("Failed to get templates of assignment %s: %v\n", assignmentID, err)
+		return nil, nil
+	}
+
+	templates := make([]similarity.Document, 0, len(files))
+	for _, file := range files {
+		id := apiclient.Value(file.FileId)
+		text, err := s.loadText(ctx, id, nil)
+		if errors.Is(err, apiclient.ErrUnavailable) {
+			return nil, err
+		}
+		if err != nil {
+			fmt.Printf("Failed to get text of template %s: %v\n", id, err)
+			continue
+		}
+		templates = append(templates, similarity.Document{ID: id, Text: text})
+	}
+	return templates, nil
+}
+
+// indexFingerprints сохраняет отпечатки файла работы в индекс, чтобы
+// последующие работы по заданию могли найти его как кандидата
+func (s *analysisService) indexFingerprints(ctx context.Context, report *models.Report, fileID string, fingerprints []similarity.Fingerprint) {
+	if report.AssignmentID == "" {
+		return
+	}
+
+	rows := make([]models.Fingerprint, len(fingerprints))
+	for i, fp := range fingerprints {
+		rows[i] = models.Fingerprint{
+			FileID:       fileID,
+			ReportID:     report.ReportID,
+			AssignmentID: report.AssignmentID,
+			Hash:         int64(fp.Hash),
+			Position:     fp.Position,
+		}
+	}
+
+	if err := s.fingerprintRepo.SaveFingerprints(ctx, fileID, rows); err != nil {
+		fmt.Printf("Failed to save fingerprints: %v\n", err)
+	}
+}
+
 // Вспомогательные функции
 func generateReportID(fileID string) string {
 	hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", fileID, time.Now().UnixNano())))
 	return fmt.Sprintf("report-%s", hex.EncodeToString(hash[:8]))
 }
 
+func generateSimilarID(reportID, originalID, similarID string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%s", reportID, originalID, similarID)))
+	return fmt.Sprintf("similar-%s", hex.EncodeToString(hash[:8]))
+}
+
 func countWords(text string) int {
 	// Удаляем лишние пробелы и символы
 	cleaned := strings.TrimSpace(text)
@@ -247,83 +927,9 @@ func getStringValue(s *string) string {
 	return ""
 }
 
-// HTTP клиент для file-storage сервиса
-type httpFileStorageClient struct {
-	baseURL string
-}
-
-func (c *httpFileStorageClient) GetFileContent(ctx context.Context, fileID string) ([]byte, error) {
-	url := fmt.Sprintf("%s/internal/files/%s/content", c.baseURL, fileID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get file content: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
-	}
-
-	return io.ReadAll(resp.Body)
-}
-
-func (c *httpFileStorageClient) GetFileMetadata(ctx context.Context, fileID string) (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/files/%s/metadata", c.baseURL, fileID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get file metadata: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
-	}
-
-	var metadata map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
-		return nil, fmt.Errorf("failed to decode metadata: %w", err)
-	}
-
-	return metadata, nil
-}
-
-func (c *httpFileStorageClient) GetSimilarWorks(ctx context.Context, fileID string) ([]string, error) {
-	url := fmt.Sprintf("%s/files/%s/exists", c.baseURL, fileID)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
 	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get similar works: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
-	}
-	var result struct {
-		Files []string `json:"files"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode similar works: %w", err)
-	}
-	return result.Files, nil
-}
-
-func calculatePlagiarismScore(text string) float32 {
-	// Заглушка: в реальном приложении была бы сложная логика анализа
-	return float32(100.00)
+	return &s
 }
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/internal/file-analysis/repository"
+	"sd_hw3/internal/file-analysis/similarity"
+	"sd_hw3/pkg/config"
+)
+
+var (
+	// ErrInvalidPolicy параметры политики вне допустимых значений
+	ErrInvalidPolicy = errors.New("invalid policy")
+	// ErrPolicyExists политика для задания уже создана
+	ErrPolicyExists = repository.ErrPolicyExists
+)
+
+const (
+	// defaultMinSimilarityPercentage минимальное сходство для similar_works, если политика не задана
+	defaultMinSimilarityPercentage = 10
+	// maxMatchLength наибольшая допустимая минимальная длина совпадения
+	maxMatchLength = 50
+)
+
+// PolicyService управление политиками анализа по заданиям
+type PolicyService interface {
+	CreatePolicy(ctx context.Context, policy *models.Policy) error
+	GetPolicy(ctx context.Context, assignmentID string) (*models.Policy, error)
+	ListPolicies(ctx context.Context) ([]*models.Policy, error)
+	UpdatePolicy(ctx context.Context, policy *models.Policy) error
+	DeletePolicy(ctx context.Context, assignmentID string) error
+	// DefaultPolicy политика, действующая для задания без собственной политики
+	DefaultPolicy(assignmentID string) *models.Policy
+	// EffectivePolicy политика задания, а если она не задана - политика по умолчанию
+	EffectivePolicy(ctx context.Context, assignmentID string) *models.Policy
+}
+
+type policyService struct {
+	config config.Config
+	repo   repository.PolicyRepository
+}
+
+func NewPolicyService(cfg config.Config, repo repository.PolicyRepository) PolicyService {
+	return &policyService{
+		config: cfg,
+		repo:   repo,
+	}
+}
+
+func (s *policyService) CreatePolicy(ctx context.Context, policy *models.Policy) error {
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.CreatedAt = time.Now()
+	policy.UpdatedAt = policy.CreatedAt
+	return s.repo.CreatePolicy(ctx, policy)
+}
+
+func (s *policyService) GetPolicy(ctx context.Context, assignmentID string) (*models.Policy, error) {
+	policy, err := s.repo.GetPolicy(ctx, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy: %w", err)
+	}
+	if policy == nil {
+		return nil, fmt.Errorf("%w: policy for assignment %s", ErrNotFound, assignmentID)
+	}
+	return policy, nil
+}
+
+func (s *policyService) ListPolicies(ctx context.Context) ([]*models.Policy, error) {
+	return s.repo.ListPolicies(ctx)
+}
+
+func (s *policyService) UpdatePolicy(ctx context.Context, policy *models.Policy) error {
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.UpdatedAt = time.Now()
+	updated, err := s.repo.UpdatePolicy(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("failed to update policy: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%w: policy for assignment %s", ErrNotFound, policy.AssignmentID)
+	}
+	return nil
+}
+
+func (s *policyService) DeletePolicy(ctx context.Context, assignmentID string) error {
+	deleted, err := s.repo.DeletePolicy(ctx, assignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("%w: policy for assignment %s", ErrNotFound, assignmentID)
+	}
+	return nil
+}
+
+func (s *policyService) DefaultPolicy(assignmentID string) *models.Policy {
+	return &models.Policy{
+		AssignmentID:            assignmentID,
+		PlagiarismThreshold:     s.config.PlagiarismThreshold,
+		MinSimilarityPercentage: defaultMinSimilarityPercentage,
+		MinMatchLength:          similarity.DefaultShingleSize,
+		Algorithm:               similarity.AlgorithmShingle,
+		IgnoreTemplateCode:      true,
+	}
+}
+
+func (s *policyService) EffectivePolicy(ctx context.Context, assignmentID string) *models.Policy {
+	if assignmentID != "" {
+		policy, err := s.repo.GetPolicy(ctx, assignmentID)
+		if err != nil {
+			fmt.Printf("Failed to get policy: %v\n", err)
+		} else if policy != nil {
+			return policy
+		}
+	}
+	return s.DefaultPolicy(assignmentID)
+}
+
+func validatePolicy(policy *models.Policy) error {
+	if policy.AssignmentID == "" {
+		return fmt.Errorf("%w: assignment_id is required", ErrInvalidPolicy)
+	}
+	if policy.PlagiarismThreshold < 0 || policy.PlagiarismThreshold > 100 {
+		return fmt.Errorf("%w: plagiarism_threshold must be between 0 and 100", ErrInvalidPolicy)
+	}
+	if policy.MinSimilarityPercentage < 0 || policy.MinSimilarityPercentage > 100 {
+		return fmt.Errorf("%w: min_similarity_percentage must be between 0 and 100", ErrInvalidPolicy)
+	}
+	if policy.MinMatchLength < 1 || policy.MinMatchLength > maxMatchLength {
+		return fmt.Errorf("%w: min_match_length must be between 1 and %d", ErrInvalidPolicy, maxMatchLength)
+	}
+	if _, err := similarity.NewEngine(policy.Algorithm, policy.MinMatchLength); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+	return nil
+}
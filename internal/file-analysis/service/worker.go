@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sd_hw3/internal/file-analysis/models"
+	"sd_hw3/internal/file-analysis/repository"
+	"sd_hw3/pkg/apiclient"
+	"sd_hw3/pkg/config"
+	"sd_hw3/pkg/resilience"
+)
+
+// WorkerPool обработчики очереди анализа. Задания хранятся в БД, поэтому
+// после перезапуска сервиса необработанные задания продолжают выполняться.
+type WorkerPool struct {
+	service      AnalysisService
+	jobs         repository.JobRepository
+	workers      int
+	pollInterval time.Duration
+	jobTimeout   time.Duration
+	maxAttempts  int
+	// retryWindow и retryMaxDelay повторы заданий, отложенных из-за
+	// недоступности file-storage
+	retryWindow   time.Duration
+	retryMaxDelay time.Duration
+	wg            sync.WaitGroup
+}
+
+func NewWorkerPool(cfg config.Config, svc AnalysisService, jobs repository.JobRepository) *WorkerPool {
+	return &WorkerPool{
+		service:      svc,
+		jobs:         jobs,
+		workers:      max(cfg.AnalysisWorkers, 1),
+		pollInterval: cfg.AnalysisPollInterval,
+		jobTimeout:   cfg.AnalysisJobTimeout,
+		maxAttempts:  max(cfg.AnalysisMaxAttempts, 1),
+
+		retryWindow:   cfg.AnalysisRetryWindow,
+		retryMaxDelay: cfg.AnalysisRetryMaxDelay,
+	}
+}
+
+// Start запускает обработчики. После отмены ctx обработчики перестают брать
+// новые задания; Wait дожидается завершения уже начатых.
+func (p *WorkerPool) Start(ctx context.Context) {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.run(ctx)
+		}()
+	}
+}
+
+// Wait ожидает остановки всех обработчиков
+func (p *WorkerPool) Wait() {
+	p.wg.Wait()
+}
+
+func (p *WorkerPool) run(ctx context.Context) {
+	for {
+		// Пока очередь не пуста, задания берутся без ожидания
+		for ctx.Err() == nil && p.processNext(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+// processNext обрабатывает одно задание; возвращает false, если очередь пуста
+func (p *WorkerPool) processNext(ctx context.Context) bool {
+	job, err := p.jobs.ClaimJob(ctx, p.jobTimeout)
+	if err != nil {
+		fmt.Printf("Failed to claim analysis job: %v\n", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	// Задание, которое раз за разом не удается довести до конца
+	// (например, из-за падения сервиса), больше не повторяется
+	if job.Attempts > p.maxAttempts {
+		errMsg := fmt.Sprintf("Analysis was interrupted %d times", job.Attempts-1)
+		if err := p.service.FailReport(ctx, job.ReportID, errMsg); err != nil {
+			fmt.Printf("Failed to mark report %s as failed: %v\n", job.ReportID, err)
+		}
+		if err := p.jobs.FailJob(ctx, job.JobID, errMsg); err != nil {
+			fmt.Printf("Failed to update analysis job %d: %v\n", job.JobID, err)
+		}
+		return true
+	}
+
+	// Начатый анализ не прерывается при остановке сервиса, но ограничен
+	// временем блокировки задания
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.jobTimeout)
+	defer cancel()
+
+	if err := p.service.ProcessReport(jobCtx, job.ReportID); err != nil {
+		if errors.Is(err, apiclient.ErrUnavailable) && time.Since(job.CreatedAt) < p.retryWindow {
+			delay := p.retryDelay(job)
+			fmt.Printf("Analysis of report %s postponed for %s: %v\n", job.ReportID, delay, err)
+			if err := p.jobs.RetryJob(jobCtx, job.JobID, err.Error(), delay); err != nil {
+				fmt.Printf("Failed to update analysis job %d: %v\n", job.JobID, err)
+			}
+			return true
+		}
+
+		fmt.Printf("Analysis of report %s failed: %v\n", job.ReportID, err)
+		if errors.Is(err, apiclient.ErrUnavailable) {
+			// Отложенный отчет остался в статусе pending
+			errMsg := fmt.Sprintf("File storage was unavailable for %s: %v", p.retryWindow, err)
+			if err := p.service.FailReport(jobCtx, job.ReportID, errMsg); err != nil {
+				fmt.Printf("Failed to mark report %s as failed: %v\n", job.ReportID, err)
+			}
+		}
+		if err := p.jobs.FailJob(jobCtx, job.JobID, err.Error()); err != nil {
+			fmt.Printf("Failed to update analysis job %d: %v\n", job.JobID, err)
+		}
+		return true
+	}
+
+	if err := p.jobs.CompleteJob(jobCtx, job.JobID); err != nil {
+		fmt.Printf("Failed to update analysis job %d: %v\n", job.JobID, err)
+	}
+	return true
+}
+
+// retryDelay задержка перед новой попыткой отложенного задания: примерно
+// столько, сколько задание уже ждет, от pollInterval до retryMaxDelay
+func (p *WorkerPool) retryDelay(job *models.AnalysisJob) time.Duration {
+	delay := max(time.Since(job.CreatedAt), p.pollInterval)
+	if p.retryMaxDelay > 0 {
+		delay = min(delay, p.retryMaxDelay)
+	}
+	return resilience.Jitter(delay)
+}
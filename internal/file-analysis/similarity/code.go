@@ -0,0 +1,208 @@
+package similarity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Значения нормализованных токенов исходного кода
+const (
+	identifierToken = "$id"
+	stringToken     = "$str"
+	numberToken     = "$num"
+)
+
+// Tokenizer разбивает текст работы на токены для сравнения
+type Tokenizer interface {
+	// Mode название режима анализа, сохраняется в отчете
+	Mode() string
+	Tokenize(text string) []Token
+}
+
+type textTokenizer struct{}
+
+// TextTokenizer токенизатор обычного текста: слова в нижнем регистре
+func TextTokenizer() Tokenizer {
+	return textTokenizer{}
+}
+
+func (textTokenizer) Mode() string {
+	return "text"
+}
+
+func (textTokenizer) Tokenize(text string) []Token {
+	return Tokenize(text)
+}
+
+type codeTokenizer struct {
+	lang *Language
+}
+
+// CodeTokenizer токенизатор исходного кода: комментарии отбрасываются,
+// идентификаторы и литералы заменяются обобщенными токенами, так что
+// переименование переменных и переформатирование не влияют на результат
+func CodeTokenizer(lang *Language) Tokenizer {
+	return codeTokenizer{lang: lang}
+}
+
+// TokenizerFor выбирает токенизатор по имени файла и content type
+func TokenizerFor(filename, contentType string) Tokenizer {
+	if lang := DetectLanguage(filename, contentType); lang != nil {
+		return CodeTokenizer(lang)
+	}
+	return TextTokenizer()
+}
+
+func (t codeTokenizer) Mode() string {
+	return "code:" + t.lang.Name
+}
+
+func (t codeTokenizer) Tokenize(text string) []Token {
+	lx := &lexer{lang: t.lang, text: text, line: 1}
+	lx.run()
+	return lx.tokens
+}
+
+type lexer struct {
+	lang   *Language
+	text   string
+	pos    int
+	line   int
+	tokens []Token
+}
+
+func (lx *lexer) run() {
+	for lx.pos < len(lx.text) {
+		rest := lx.text[lx.pos:]
+		c := rest[0]
+
+		switch {
+		case c == '\n':
+			lx.line++
+			lx.pos++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			lx.pos++
+		case lx.skipComment(rest):
+		case lx.lang.TripleQuotes && (strings.HasPrefix(rest, `"""`) || strings.HasPrefix(rest, `'''`)):
+			lx.scanUntil(rest[:3], 3, false)
+		case lx.lang.RawQuote != 0 && c == lx.lang.RawQuote:
+			lx.scanUntil(string(c), 1, false)
+		case strings.IndexByte(lx.lang.Quotes, c) >= 0:
+			lx.scanUntil(string(c), 1, true)
+		case isDigit(c) || (c == '.' && len(rest) > 1 && isDigit(rest[1])):
+			lx.scanNumber()
+		default:
+			r, size := utf8.DecodeRuneInString(rest)
+			if r == '_' || unicode.IsLetter(r) {
+				lx.scanIdentifier()
+				continue
+			}
+			// Точка с запятой часто необязательна и не несет смысла для сравнения
+			if c != ';' {
+				lx.emit(string(rest[:size]), lx.pos, size, lx.line)
+			}
+			lx.pos += size
+		}
+	}
+}
+
+// skipComment пропускает комментарий, если он начинается в текущей позиции
+func (lx *lexer) skipComment(rest string) bool {
+	for _, prefix := range lx.lang.LineComments {
+		if strings.HasPrefix(rest, prefix) {
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			lx.pos += end
+			return true
+		}
+	}
+	for _, delims := range lx.lang.BlockComments {
+		if strings.HasPrefix(rest, delims[0]) {
+			end := strings.Index(rest[len(delims[0]):], delims[1])
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += len(delims[0]) + len(delims[1])
+			}
+			lx.line += strings.Count(rest[:end], "\n")
+			lx.pos += end
+			return true
+		}
+	}
+	return false
+}
+
+// scanUntil считывает строковый литерал до закрывающей последовательности
+func (lx *lexer) scanUntil(closing string, openLen int, escapes bool) {
+	start, startLine := lx.pos, lx.line
+	lx.pos += openLen
+	for lx.pos < len(lx.text) {
+		c := lx.text[lx.pos]
+		if escapes && c == '\\' {
+			if lx.pos+1 < len(lx.text) && lx.text[lx.pos+1] == '\n' {
+				lx.line++
+			}
+			lx.pos += 2
+			continue
+		}
+		if strings.HasPrefix(lx.text[lx.pos:], closing) {
+			lx.pos += len(closing)
+			break
+		}
+		if c == '\n' {
+			// Обычные строки не переносятся: незакрытая кавычка не должна поглотить весь файл
+			if escapes {
+				break
+			}
+			lx.line++
+		}
+		lx.pos++
+	}
+	lx.pos = min(lx.pos, len(lx.text))
+	lx.emit(stringToken, start, lx.pos-start, startLine)
+}
+
+func (lx *lexer) scanNumber() {
+	start := lx.pos
+	for lx.pos < len(lx.text) {
+		c := lx.text[lx.pos]
+		if !isDigit(c) && !isASCIILetter(c) && c != '.' && c != '_' {
+			break
+		}
+		lx.pos++
+	}
+	lx.emit(numberToken, start, lx.pos-start, lx.line)
+}
+
+func (lx *lexer) scanIdentifier() {
+	start := lx.pos
+	for lx.pos < len(lx.text) {
+		r, size := utf8.DecodeRuneInString(lx.text[lx.pos:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		lx.pos += size
+	}
+
+	word := lx.text[start:lx.pos]
+	value := identifierToken
+	if _, ok := lx.lang.Keywords[word]; ok {
+		value = word
+	}
+	lx.emit(value, start, lx.pos-start, lx.line)
+}
+
+func (lx *lexer) emit(value string, offset, length, line int) {
+	lx.tokens = append(lx.tokens, Token{Value: value, Offset: offset, Length: length, Line: line})
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,275 @@
+package similarity
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"sort"
+)
+
+// DefaultShingleSize количество слов в одном шингле по умолчанию
+const DefaultShingleSize = 5
+
+// Алгоритмы сравнения
+const (
+	// AlgorithmShingle сравнение по всем шинглам работ
+	AlgorithmShingle = "shingle"
+	// AlgorithmWinnowing сравнение только по отпечаткам winnowing: быстрее
+	// и устойчивее к коротким случайным совпадениям, но менее точно
+	AlgorithmWinnowing = "winnowing"
+)
+
+// Document работа, участвующая в сравнении
+type Document struct {
+	ID   string
+	Text string
+}
+
+// Match результат сравнения работы с одной из работ корпуса
+type Match struct {
+	DocumentID           string
+	SimilarityPercentage float32
+	Fragments            []Fragment
+}
+
+// Fragment совпавший участок текста. Source описывает участок в анализируемой
+// работе, Target - в работе из корпуса.
+type Fragment struct {
+	Source Span
+	Target Span
+}
+
+// Span участок текста
+type Span struct {
+	Offset    int // смещение начала в байтах
+	Length    int // длина в байтах
+	StartLine int // первая строка участка, начиная с 1
+	EndLine   int // последняя строка участка
+}
+
+// Result итог сравнения работы со всем корпусом
+type Result struct {
+	// PlagiarismScore доля шинглов работы (0-100), найденных хотя бы в одной работе корпуса
+	PlagiarismScore float32
+	// Matches работы корпуса с ненулевым сходством, по убыванию сходства
+	Matches []Match
+}
+
+// Engine движок поиска текстовых заимствований
+type Engine interface {
+	// Compare сравнивает работу с корпусом, разбивая все тексты на токены tokenizer.
+	// Участки, совпадающие с шаблонами задания templates, не учитываются ни в
+	// PlagiarismScore, ни в сходстве с работами корпуса, ни во фрагментах.
+	Compare(submission Document, corpus []Document, templates []Document, tokenizer Tokenizer) Result
+}
+
+type shingleEngine struct {
+	shingleSize int
+	// windowSize размер окна winnowing; 0 - сравниваются все шинглы
+	windowSize int
+}
+
+// NewShingleEngine создает движок, сравнивающий работы по пересечению
+// множеств шинглов (n-грамм слов) длины shingleSize
+func NewShingleEngine(shingleSize int) Engine {
+	if shingleSize <= 0 {
+		shingleSize = DefaultShingleSize
+	}
+	return &shingleEngine{shingleSize: shingleSize}
+}
+
+// NewWinnowingEngine создает движок, сравнивающий работы по пересечению
+// множеств отпечатков winnowing. Фрагменты ищутся так же, как в NewShingleEngine.
+func NewWinnowingEngine(shingleSize, windowSize int) Engine {
+	if shingleSize <= 0 {
+		shingleSize = DefaultShingleSize
+	}
+	if windowSize <= 0 {
+		windowSize = DefaultWindowSize
+	}
+	return &shingleEngine{shingleSize: shingleSize, windowSize: windowSize}
+}
+
+// NewEngine создает движок по названию алгоритма
+func NewEngine(algorithm string, shingleSize int) (Engine, error) {
+	switch algorithm {
+	case AlgorithmShingle, "":
+		return NewShingleEngine(shingleSize), nil
+	case AlgorithmWinnowing:
+		return NewWinnowingEngine(shingleSize, DefaultWindowSize), nil
+	}
+	return nil, fmt.Errorf("unknown algorithm %q", algorithm)
+}
+
+func (e *shingleEngine) Compare(submission Document, corpus []Document, templates []Document, tokenizer Tokenizer) Result {
+	sourceTokens := tokenizer.Tokenize(submission.Text)
+	source := e.shingles(sourceTokens)
+
+	excluded := e.templateShingles(templates, tokenizer)
+	for hash := range excluded {
+		delete(source, hash)
+	}
+	if len(source) == 0 {
+		return Result{}
+	}
+
+	covered := make(map[uint64]struct{})
+	var matches []Match
+	for _, doc := range corpus {
+		if doc.ID == submission.ID {
+			continue
+		}
+
+		targetTokens := tokenizer.Tokenize(doc.Text)
+		target := e.shingles(targetTokens)
+		common := 0
+		for hash := range source {
+			if _, ok := target[hash]; ok {
+				common++
+				covered[hash] = struct{}{}
+			}
+		}
+		if common == 0 {
+			continue
+		}
+
+		matches = append(matches, Match{
+			DocumentID:           doc.ID,
+			SimilarityPercentage: percentage(common, len(source)),
+			Fragments:            e.fragments(sourceTokens, targetTokens, excluded),
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].SimilarityPercentage > matches[j].SimilarityPercentage
+	})
+
+	return Result{
+		PlagiarismScore: percentage(len(covered), len(source)),
+		Matches:         matches,
+	}
+}
+
+// shingles возвращает множество хэшей шинглов последовательности токенов
+// (при включенном winnowing - только выбранных отпечатков).
+// Если токенов меньше размера шингла, весь текст считается одним шинглом.
+func (e *shingleEngine) shingles(tokens []Token) map[uint64]struct{} {
+	result := make(map[uint64]struct{})
+	if len(tokens) == 0 {
+		return result
+	}
+
+	if e.windowSize > 0 {
+		for _, fp := range Winnow(tokens, e.shingleSize, e.windowSize) {
+			result[fp.Hash] = struct{}{}
+		}
+		return result
+	}
+
+	size := min(e.shingleSize, len(tokens))
+	for i := 0; i+size <= len(tokens); i++ {
+		result[hashTokens(tokens[i:i+size])] = struct{}{}
+	}
+	return result
+}
+
+// templateShingles возвращает хэши всех шинглов шаблонов задания. Шинглы
+// берутся без winnowing, чтобы исключить любой отпечаток работы, попавший в шаблон.
+func (e *shingleEngine) templateShingles(templates []Document, tokenizer Tokenizer) map[uint64]struct{} {
+	result := make(map[uint64]struct{})
+	for _, doc := range templates {
+		tokens := tokenizer.Tokenize(doc.Text)
+		size := min(e.shingleSize, len(tokens))
+		for i := 0; size > 0 && i+size <= len(tokens); i++ {
+			result[hashTokens(tokens[i:i+size])] = struct{}{}
+		}
+	}
+	return result
+}
+
+// fragments находит совпавшие участки двух текстов: подряд идущие общие шинглы,
+// которые идут подряд и во второй работе, объединяются в один фрагмент.
+// Шинглы из excluded (совпадения с шаблоном) разрывают фрагмент.
+func (e *shingleEngine) fragments(source, target []Token, excluded map[uint64]struct{}) []Fragment {
+	size := min(e.shingleSize, len(source), len(target))
+	if size == 0 {
+		return nil
+	}
+
+	positions := make(map[uint64][]int)
+	for j := 0; j+size <= len(target); j++ {
+		hash := hashTokens(target[j : j+size])
+		positions[hash] = append(positions[hash], j)
+	}
+
+	var fragments []Fragment
+	// [startI, endI] и [startJ, endJ] - индексы первых токенов шинглов текущего фрагмента
+	startI, startJ, endI, endJ := -1, -1, -1, -1
+	flush := func() {
+		if startI < 0 {
+			return
+		}
+		fragments = append(fragments, Fragment{
+			Source: span(source[startI : endI+size]),
+			Target: span(target[startJ : endJ+size]),
+		})
+		startI = -1
+	}
+
+	for i := 0; i+size <= len(source); i++ {
+		hash := hashTokens(source[i : i+size])
+		if _, ok := excluded[hash]; ok {
+			flush()
+			continue
+		}
+		candidates := positions[hash]
+		if len(candidates) == 0 {
+			flush()
+			continue
+		}
+		if startI >= 0 && slices.Contains(candidates, endJ+1) {
+			endI, endJ = i, endJ+1
+			continue
+		}
+		flush()
+		startI, endI = i, i
+		startJ, endJ = candidates[0], candidates[0]
+	}
+	flush()
+
+	return fragments
+}
+
+func span(tokens []Token) Span {
+	first, last := tokens[0], tokens[len(tokens)-1]
+	return Span{
+		Offset:    first.Offset,
+		Length:    last.Offset + last.Length - first.Offset,
+		StartLine: first.Line,
+		EndLine:   last.Line,
+	}
+}
+
+func hashTokens(tokens []Token) uint64 {
+	h := fnv.New64a()
+	for _, t := range tokens {
+		h.Write([]byte(t.Value))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// IsPlagiarism оценка работы score превышает порог threshold; оценка, равная
+// порогу, заимствованием не считается
+func IsPlagiarism(score, threshold float32) bool {
+	return score > threshold
+}
+
+// percentage считает долю part от total в процентах с точностью до сотых
+func percentage(part, total int) float32 {
+	if total == 0 {
+		return 0
+	}
+	return float32(math.Round(float64(part)*10000/float64(total)) / 100)
+}
@@ -0,0 +1,222 @@
+package similarity
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// words текст из n различных слов с префиксом prefix
+func words(prefix string, n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestCompare(t *testing.T) {
+	a, b := words("a", 10), words("b", 10)
+
+	tests := []struct {
+		name      string
+		source    string
+		target    string
+		templates []string
+		score     float32
+		fragments int
+	}{
+		{name: "identical", source: a, target: a, score: 100, fragments: 1},
+		{name: "identical ignoring case and punctuation", source: a, target: strings.ToUpper(a) + ".", score: 100, fragments: 1},
+		{name: "disjoint", source: a, target: b, score: 0},
+		// Из 16 шинглов текста AB общими с BA остаются 6 внутри A и 6 внутри B
+		{name: "reordered", source: a + " " + b, target: b + " " + a, score: 75, fragments: 2},
+		{name: "half copied", source: a + " " + b, target: a, score: 37.5, fragments: 1},
+		{name: "short identical", source: "x y z", target: "x y z", score: 100, fragments: 1},
+		{name: "short different", source: "x y z", target: "x y w", score: 0},
+		{name: "empty source", source: "", target: a, score: 0},
+		{name: "empty target", source: a, target: "", score: 0},
+		// Совпадение только с шаблоном задания заимствованием не считается
+		{name: "template only", source: a, target: a, templates: []string{a}, score: 0},
+		{name: "template part", source: a + " " + b, target: a + " " + b, templates: []string{a}, score: 100, fragments: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var templates []Document
+			for i, text := range tt.templates {
+				templates = append(templates, Document{ID: fmt.Sprintf("template-%d", i), Text: text})
+			}
+
+			result := NewShingleEngine(DefaultShingleSize).Compare(
+				Document{ID: "source", Text: tt.source},
+				[]Document{{ID: "target", Text: tt.target}},
+				templates, TextTokenizer())
+
+			if result.PlagiarismScore != tt.score {
+				t.Fatalf("PlagiarismScore = %v, want %v", result.PlagiarismScore, tt.score)
+			}
+			if tt.score == 0 {
+				if len(result.Matches) != 0 {
+					t.Fatalf("Matches = %+v, want none", result.Matches)
+				}
+				return
+			}
+			if len(result.Matches) != 1 {
+				t.Fatalf("len(Matches) = %d, want 1", len(result.Matches))
+			}
+			match := result.Matches[0]
+			if match.DocumentID != "target" || match.SimilarityPercentage != tt.score {
+				t.Errorf("match = %s %v, want target %v", match.DocumentID, match.SimilarityPercentage, tt.score)
+			}
+			if len(match.Fragments) != tt.fragments {
+				t.Errorf("len(Fragments) = %d, want %d: %+v", len(match.Fragments), tt.fragments, match.Fragments)
+			}
+		})
+	}
+}
+
+func TestCompareFragmentSpans(t *testing.T) {
+	source := "intro words here\n" + words("a", 10)
+	target := words("a", 10) + "\noutro"
+
+	result := NewShingleEngine(DefaultShingleSize).Compare(
+		Document{ID: "source", Text: source},
+		[]Document{{ID: "target", Text: target}},
+		nil, TextTokenizer())
+	if len(result.Matches) != 1 || len(result.Matches[0].Fragments) != 1 {
+		t.Fatalf("Matches = %+v, want one match with one fragment", result.Matches)
+	}
+
+	fragment := result.Matches[0].Fragments[0]
+	copied := words("a", 10)
+	if got := source[fragment.Source.Offset : fragment.Source.Offset+fragment.Source.Length]; got != copied {
+		t.Errorf("source fragment = %q, want %q", got, copied)
+	}
+	if got := target[fragment.Target.Offset : fragment.Target.Offset+fragment.Target.Length]; got != copied {
+		t.Errorf("target fragment = %q, want %q", got, copied)
+	}
+	if fragment.Source.StartLine != 2 || fragment.Source.EndLine != 2 {
+		t.Errorf("source lines = %d-%d, want 2-2", fragment.Source.StartLine, fragment.Source.EndLine)
+	}
+	if fragment.Target.StartLine != 1 || fragment.Target.EndLine != 1 {
+		t.Errorf("target lines = %d-%d, want 1-1", fragment.Target.StartLine, fragment.Target.EndLine)
+	}
+}
+
+func TestCompareOrdersMatchesAndSkipsSelf(t *testing.T) {
+	a, b := words("a", 10), words("b", 10)
+	source := Document{ID: "source", Text: a + " " + b}
+	corpus := []Document{
+		{ID: "source", Text: a + " " + b},
+		{ID: "half", Text: a},
+		{ID: "full", Text: a + " " + b},
+		{ID: "other", Text: words("c", 10)},
+	}
+
+	result := NewShingleEngine(DefaultShingleSize).Compare(source, corpus, nil, TextTokenizer())
+
+	var ids []string
+	for _, match := range result.Matches {
+		ids = append(ids, match.DocumentID)
+	}
+	if got := strings.Join(ids, ","); got != "full,half" {
+		t.Errorf("matches = %s, want full,half", got)
+	}
+	if result.PlagiarismScore != 100 {
+		t.Errorf("PlagiarismScore = %v, want 100", result.PlagiarismScore)
+	}
+}
+
+func TestWinnowingEngine(t *testing.T) {
+	a, b := words("a", 20), words("b", 20)
+
+	tests := []struct {
+		name   string
+		source string
+		target string
+		min    float32
+		max    float32
+	}{
+		{name: "identical", source: a, target: a, min: 100, max: 100},
+		{name: "disjoint", source: a, target: b, min: 0, max: 0},
+		{name: "reordered", source: a + " " + b, target: b + " " + a, min: 50, max: 99.99},
+		{name: "short identical", source: "x y", target: "x y", min: 100, max: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewWinnowingEngine(DefaultShingleSize, DefaultWindowSize).Compare(
+				Document{ID: "source", Text: tt.source},
+				[]Document{{ID: "target", Text: tt.target}},
+				nil, TextTokenizer())
+			if result.PlagiarismScore < tt.min || result.PlagiarismScore > tt.max {
+				t.Errorf("PlagiarismScore = %v, want %v..%v", result.PlagiarismScore, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestNewEngine(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		wantErr   bool
+	}{
+		{algorithm: ""},
+		{algorithm: AlgorithmShingle},
+		{algorithm: AlgorithmWinnowing},
+		{algorithm: "moss", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		engine, err := NewEngine(tt.algorithm, 0)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewEngine(%q) error = %v, wantErr %v", tt.algorithm, err, tt.wantErr)
+		}
+		if err == nil && engine == nil {
+			t.Errorf("NewEngine(%q) returned nil engine", tt.algorithm)
+		}
+	}
+}
+
+func TestIsPlagiarism(t *testing.T) {
+	tests := []struct {
+		score     float32
+		threshold float32
+		want      bool
+	}{
+		{score: 0, threshold: 0, want: false},
+		{score: 0.01, threshold: 0, want: true},
+		{score: 49.99, threshold: 50, want: false},
+		{score: 50, threshold: 50, want: false},
+		{score: 50.01, threshold: 50, want: true},
+		{score: 100, threshold: 100, want: false},
+		{score: 100, threshold: 99.99, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := IsPlagiarism(tt.score, tt.threshold); got != tt.want {
+			t.Errorf("IsPlagiarism(%v, %v) = %v, want %v", tt.score, tt.threshold, got, tt.want)
+		}
+	}
+}
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		part, total int
+		want        float32
+	}{
+		{part: 0, total: 0, want: 0},
+		{part: 0, total: 7, want: 0},
+		{part: 7, total: 7, want: 100},
+		{part: 1, total: 3, want: 33.33},
+		{part: 2, total: 3, want: 66.67},
+		{part: 1, total: 8, want: 12.5},
+	}
+
+	for _, tt := range tests {
+		if got := percentage(tt.part, tt.total); got != tt.want {
+			t.Errorf("percentage(%d, %d) = %v, want %v", tt.part, tt.total, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package similarity
+
+import (
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+// Language описание синтаксиса языка программирования, достаточное для лексера
+type Language struct {
+	Name          string
+	Keywords      map[string]struct{}
+	LineComments  []string
+	BlockComments [][2]string
+	// Quotes символы, открывающие строковые литералы
+	Quotes string
+	// TripleQuotes включает строки в тройных кавычках (Python)
+	TripleQuotes bool
+	// RawQuote символ строк без экранирования (` в Go и JavaScript)
+	RawQuote byte
+}
+
+func keywords(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+var cBlockComments = [][2]string{{"/*", "*/"}}
+
+var (
+	LanguageGo = &Language{
+		Name: "go",
+		Keywords: keywords("break", "case", "chan", "const", "continue", "default", "defer", "else",
+			"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package",
+			"range", "return", "select", "struct", "switch", "type", "var", "nil", "true", "false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+		RawQuote:      '`',
+	}
+	LanguagePython = &Language{
+		Name: "python",
+		Keywords: keywords("and", "as", "assert", "async", "await", "break", "class", "continue", "def",
+			"del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with",
+			"yield", "None", "True", "False"),
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+		TripleQuotes: true,
+	}
+	LanguageJava = &Language{
+		Name: "java",
+		Keywords: keywords("abstract", "assert", "boolean", "break", "byte", "case", "catch", "char",
+			"class", "const", "continue", "default", "do", "double", "else", "enum", "extends", "final",
+			"finally", "float", "for", "goto", "if", "implements", "import", "instanceof", "int",
+			"interface", "long", "native", "new", "package", "private", "protected", "public", "return",
+			"short", "static", "super", "switch", "synchronized", "this", "throw", "throws", "try",
+			"void", "volatile", "while", "var", "record", "null", "true", "false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+	}
+	LanguageC = &Language{
+		Name: "c",
+		Keywords: keywords("auto", "break", "case", "char", "const", "continue", "default", "do",
+			"double", "else", "enum", "extern", "float", "for", "goto", "if", "inline", "int", "long",
+			"register", "return", "short", "signed", "sizeof", "static", "struct", "switch", "typedef",
+			"union", "unsigned", "void", "volatile", "while", "NULL"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+	}
+	LanguageCPP = &Language{
+		Name: "cpp",
+		Keywords: keywords("auto", "bool", "break", "case", "catch", "char", "class", "const",
+			"constexpr", "continue", "default", "delete", "do", "double", "else", "enum", "explicit",
+			"extern", "float", "for", "friend", "goto", "if", "inline", "int", "long", "namespace",
+			"new", "nullptr", "operator", "private", "protected", "public", "return", "short", "signed",
+			"sizeof", "static", "struct", "switch", "template", "this", "throw", "try", "typedef",
+			"typename", "union", "unsigned", "using", "virtual", "void", "volatile", "while", "true",
+			"false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+	}
+	LanguageCSharp = &Language{
+		Name: "csharp",
+		Keywords: keywords("abstract", "as", "base", "bool", "break", "byte", "case", "catch", "char",
+			"class", "const", "continue", "decimal", "default", "delegate", "do", "double", "else",
+			"enum", "event", "explicit", "extern", "float", "for", "foreach", "goto", "if", "implicit",
+			"in", "int", "interface", "internal", "is", "lock", "long", "namespace", "new", "null",
+			"object", "operator", "out", "override", "params", "private", "protected", "public",
+			"readonly", "ref", "return", "sealed", "short", "sizeof", "static", "string", "struct",
+			"switch", "this", "throw", "try", "typeof", "uint", "ulong", "using", "var", "virtual",
+			"void", "while", "true", "false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+	}
+	LanguageJavaScript = &Language{
+		Name: "javascript",
+		Keywords: keywords("async", "await", "break", "case", "catch", "class", "const", "continue",
+			"default", "delete", "do", "else", "export", "extends", "finally", "for", "function", "if",
+			"import", "in", "instanceof", "let", "new", "of", "return", "super", "switch", "this",
+			"throw", "try", "typeof", "var", "void", "while", "yield", "null", "undefined", "true",
+			"false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+		RawQuote:      '`',
+	}
+	LanguageTypeScript = &Language{
+		Name: "typescript",
+		Keywords: keywords("async", "await", "break", "case", "catch", "class", "const", "continue",
+			"default", "delete", "do", "else", "enum", "export", "extends", "finally", "for", "function",
+			"if", "implements", "import", "in", "instanceof", "interface", "let", "new", "of",
+			"private", "protected", "public", "readonly", "return", "super", "switch", "this", "throw",
+			"try", "type", "typeof", "var", "void", "while", "yield", "null", "undefined", "true",
+			"false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+		RawQuote:      '`',
+	}
+	LanguageKotlin = &Language{
+		Name: "kotlin",
+		Keywords: keywords("as", "break", "class", "continue", "do", "else", "for", "fun", "if", "in",
+			"interface", "is", "object", "package", "return", "super", "this", "throw", "try",
+			"typealias", "val", "var", "when", "while", "null", "true", "false"),
+		LineComments:  []string{"//"},
+		BlockComments: cBlockComments,
+		Quotes:        `"'`,
+	}
+)
+
+var languagesByExtension = map[string]*Language{
+	".go":   LanguageGo,
+	".py":   LanguagePython,
+	".java": LanguageJava,
+	".c":    LanguageC,
+	".h":    LanguageC,
+	".cpp":  LanguageCPP,
+	".cc":   LanguageCPP,
+	".cxx":  LanguageCPP,
+	".hpp":  LanguageCPP,
+	".hh":   LanguageCPP,
+	".cs":   LanguageCSharp,
+	".js":   LanguageJavaScript,
+	".mjs":  LanguageJavaScript,
+	".cjs":  LanguageJavaScript,
+	".jsx":  LanguageJavaScript,
+	".ts":   LanguageTypeScript,
+	".tsx":  LanguageTypeScript,
+	".kt":   LanguageKotlin,
+	".kts":  LanguageKotlin,
+}
+
+var languagesByContentType = map[string]*Language{
+	"text/x-go":                LanguageGo,
+	"text/x-python":            LanguagePython,
+	"text/x-script.python":     LanguagePython,
+	"application/x-python":     LanguagePython,
+	"text/x-java":              LanguageJava,
+	"text/x-java-source":       LanguageJava,
+	"text/x-c":                 LanguageC,
+	"text/x-csrc":              LanguageC,
+	"text/x-chdr":              LanguageC,
+	"text/x-c++src":            LanguageCPP,
+	"text/x-c++hdr":            LanguageCPP,
+	"text/x-csharp":            LanguageCSharp,
+	"text/javascript":          LanguageJavaScript,
+	"application/javascript":   LanguageJavaScript,
+	"application/x-javascript": LanguageJavaScript,
+	"application/typescript":   LanguageTypeScript,
+	"text/x-typescript":        LanguageTypeScript,
+	"text/x-kotlin":            LanguageKotlin,
+}
+
+// DetectLanguage определяет язык программирования по имени файла, а если
+// расширение неизвестно - по content type. Возвращает nil для обычного текста.
+func DetectLanguage(filename, contentType string) *Language {
+	if lang, ok := languagesByExtension[strings.ToLower(filepath.Ext(filename))]; ok {
+		return lang
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return languagesByContentType[mediaType]
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package similarity
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token слово исходного текста вместе с его позицией
+type Token struct {
+	Value  string // нормализованное значение токена
+	Offset int    // смещение начала токена в байтах
+	Length int    // длина токена в исходном тексте в байтах
+	Line   int    // номер строки, начиная с 1
+}
+
+// Tokenize разбивает текст на слова, приводя их к нижнему регистру.
+// Словом считается непрерывная последовательность букв и цифр.
+func Tokenize(text string) []Token {
+	var tokens []Token
+
+	line := 1
+	start := -1
+	startLine := 1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+				startLine = line
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, newToken(text, start, i, startLine))
+			start = -1
+		}
+		if r == '\n' {
+			line++
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, newToken(text, start, len(text), startLine))
+	}
+
+	return tokens
+}
+
+func newToken(text string, start, end, line int) Token {
+	return Token{
+		Value:  strings.ToLower(text[start:end]),
+		Offset: start,
+		Length: end - start,
+		Line:   line,
+	}
+}
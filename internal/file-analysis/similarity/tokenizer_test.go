@@ -0,0 +1,65 @@
+package similarity
+
+import (
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Token
+	}{
+		{name: "empty", text: ""},
+		{name: "punctuation only", text: " ,.!\n"},
+		{
+			name: "case and punctuation",
+			text: "Hello, World!",
+			want: []Token{
+				{Value: "hello", Offset: 0, Length: 5, Line: 1},
+				{Value: "world", Offset: 7, Length: 5, Line: 1},
+			},
+		},
+		{
+			name: "lines",
+			text: "one\n\ntwo three\nfour",
+			want: []Token{
+				{Value: "one", Offset: 0, Length: 3, Line: 1},
+				{Value: "two", Offset: 5, Length: 3, Line: 3},
+				{Value: "three", Offset: 9, Length: 5, Line: 3},
+				{Value: "four", Offset: 15, Length: 4, Line: 4},
+			},
+		},
+		{
+			name: "letters and digits",
+			text: "x1 42",
+			want: []Token{
+				{Value: "x1", Offset: 0, Length: 2, Line: 1},
+				{Value: "42", Offset: 3, Length: 2, Line: 1},
+			},
+		},
+		{
+			// Смещения в байтах, а не в символах
+			name: "unicode",
+			text: "Привет мир",
+			want: []Token{
+				{Value: "привет", Offset: 0, Length: 12, Line: 1},
+				{Value: "мир", Offset: 13, Length: 6, Line: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokenize(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Tokenize(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("token %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package similarity
+
+// DefaultWindowSize размер окна winnowing по умолчанию. Любое совпадение
+// длиной не меньше DefaultShingleSize+DefaultWindowSize-1 слов гарантированно
+// дает хотя бы один общий отпечаток.
+const DefaultWindowSize = 4
+
+// Fingerprint отпечаток k-граммы, выбранный алгоритмом winnowing
+type Fingerprint struct {
+	Hash     uint64
+	Position int // индекс первого токена k-граммы
+}
+
+// Winnow вычисляет отпечатки документа по алгоритму winnowing (как в MOSS):
+// в каждом окне из window последовательных хэшей k-грамм выбирается
+// минимальный (при равенстве самый правый), повторно выбранные хэши
+// одной и той же позиции не дублируются.
+func Winnow(tokens []Token, k, window int) []Fingerprint {
+	if len(tokens) == 0 {
+		return nil
+	}
+	k = min(k, len(tokens))
+
+	hashes := make([]uint64, 0, len(tokens)-k+1)
+	for i := 0; i+k <= len(tokens); i++ {
+		hashes = append(hashes, hashTokens(tokens[i:i+k]))
+	}
+
+	window = min(max(window, 1), len(hashes))
+
+	var fingerprints []Fingerprint
+	selected := -1
+	for start := 0; start+window <= len(hashes); start++ {
+		minPos := start
+		for i := start + 1; i < start+window; i++ {
+			if hashes[i] <= hashes[minPos] {
+				minPos = i
+			}
+		}
+		if minPos != selected {
+			selected = minPos
+			fingerprints = append(fingerprints, Fingerprint{Hash: hashes[minPos], Position: minPos})
+		}
+	}
+
+	return fingerprints
+}
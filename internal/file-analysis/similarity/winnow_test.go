@@ -0,0 +1,111 @@
+package similarity
+
+import (
+	"testing"
+)
+
+func hashSet(fingerprints []Fingerprint) map[uint64]struct{} {
+	set := make(map[uint64]struct{}, len(fingerprints))
+	for _, fp := range fingerprints {
+		set[fp.Hash] = struct{}{}
+	}
+	return set
+}
+
+func TestWinnow(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		k      int
+		window int
+		want   int // число отпечатков; -1 - не проверяется
+	}{
+		{name: "empty", text: "", k: 5, window: 4, want: 0},
+		// Текст короче k-граммы дает одну k-грамму из всех слов
+		{name: "shorter than k", text: "x y z", k: 5, window: 4, want: 1},
+		{name: "exactly k", text: "v w x y z", k: 5, window: 4, want: 1},
+		// Окно больше числа k-грамм сжимается до их числа
+		{name: "window larger than hashes", text: "t u v w x y z", k: 5, window: 10, want: 1},
+		// При окне 1 выбирается каждая k-грамма
+		{name: "window of one", text: words("a", 10), k: 5, window: 1, want: 6},
+		{name: "long text", text: words("a", 100), k: 5, window: 4, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := Tokenize(tt.text)
+			fingerprints := Winnow(tokens, tt.k, tt.window)
+
+			if tt.want >= 0 && len(fingerprints) != tt.want {
+				t.Fatalf("len(Winnow) = %d, want %d", len(fingerprints), tt.want)
+			}
+
+			hashes := len(tokens) - min(tt.k, len(tokens)) + 1
+			for i, fp := range fingerprints {
+				if fp.Position < 0 || fp.Position >= hashes {
+					t.Fatalf("fingerprint %d at position %d, want 0..%d", i, fp.Position, hashes-1)
+				}
+				if i > 0 && fp.Position <= fingerprints[i-1].Position {
+					t.Fatalf("positions not increasing: %d after %d", fp.Position, fingerprints[i-1].Position)
+				}
+			}
+		})
+	}
+}
+
+func TestWinnowDeterministic(t *testing.T) {
+	tokens := Tokenize(words("a", 50))
+	first := Winnow(tokens, DefaultShingleSize, DefaultWindowSize)
+	second := Winnow(tokens, DefaultShingleSize, DefaultWindowSize)
+
+	if len(first) != len(second) {
+		t.Fatalf("len = %d and %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("fingerprint %d = %+v and %+v", i, first[i], second[i])
+		}
+	}
+}
+
+// Совпадение длиной не меньше k+window-1 слов всегда дает общий отпечаток,
+// в каком бы окружении оно ни стояло
+func TestWinnowGuarantee(t *testing.T) {
+	shared := words("s", DefaultShingleSize+DefaultWindowSize-1)
+
+	tests := []struct {
+		name   string
+		source string
+		target string
+	}{
+		{name: "same position", source: shared, target: shared},
+		{name: "different prefixes", source: words("a", 7) + " " + shared, target: words("b", 3) + " " + shared},
+		{name: "different suffixes", source: shared + " " + words("a", 9), target: shared + " " + words("b", 2)},
+		{name: "surrounded", source: words("a", 13) + " " + shared + " " + words("c", 5), target: words("b", 1) + " " + shared + " " + words("d", 11)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := hashSet(Winnow(Tokenize(tt.source), DefaultShingleSize, DefaultWindowSize))
+			target := hashSet(Winnow(Tokenize(tt.target), DefaultShingleSize, DefaultWindowSize))
+
+			for hash := range source {
+				if _, ok := target[hash]; ok {
+					return
+				}
+			}
+			t.Fatal("no common fingerprint")
+		})
+	}
+}
+
+func TestWinnowDisjoint(t *testing.T) {
+	source := hashSet(Winnow(Tokenize(words("a", 40)), DefaultShingleSize, DefaultWindowSize))
+	target := hashSet(Winnow(Tokenize(words("b", 40)), DefaultShingleSize, DefaultWindowSize))
+
+	for hash := range source {
+		if _, ok := target[hash]; ok {
+			t.Fatalf("common fingerprint %x in disjoint texts", hash)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package archive распознает архивы (ZIP, TAR, TAR.GZ) и перебирает их файлы.
+// Размер распакованного содержимого и число файлов ограничиваются, а пути
+// внутри архива проверяются, поэтому zip-бомбы и пути вида ../../etc/passwd
+// отклоняются ошибкой ErrInvalidArchive.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// Format формат архива
+type Format string
+
+const (
+	FormatNone  Format = ""
+	FormatZip   Format = "zip"
+	FormatTar   Format = "tar"
+	FormatTarGz Format = "tar.gz"
+)
+
+var (
+	// ErrInvalidArchive архив поврежден или нарушает ограничения
+	ErrInvalidArchive = errors.New("invalid archive")
+	// ErrUnsafePath путь файла в архиве абсолютный или выходит за пределы архива
+	ErrUnsafePath = fmt.Errorf("%w: unsafe member path", ErrInvalidArchive)
+	// ErrTooManyMembers в архиве больше файлов, чем Limits.MaxMembers
+	ErrTooManyMembers = fmt.Errorf("%w: too many members", ErrInvalidArchive)
+	// ErrTooLarge распакованное содержимое больше Limits.MaxUnpackedSize
+	ErrTooLarge = fmt.Errorf("%w: unpacked size limit exceeded", ErrInvalidArchive)
+)
+
+// Limits ограничения на распаковку
+type Limits struct {
+	// MaxMembers наибольшее число файлов в архиве
+	MaxMembers int
+	// MaxUnpackedSize наибольший суммарный размер распакованных файлов. Считаются
+	// действительно прочитанные байты, а не размеры из заголовков архива.
+	MaxUnpackedSize int64
+}
+
+// Member файл внутри архива
+type Member struct {
+	// Path путь внутри архива через "/", без "." и ".."
+	Path string
+}
+
+var (
+	zipMagic      = []byte("PK\x03\x04")
+	emptyZipMagic = []byte("PK\x05\x06")
+	gzipMagic     = []byte{0x1f, 0x8b}
+	tarMagic      = []byte("ustar")
+)
+
+// tarMagicOffset смещение сигнатуры ustar в заголовке tar
+const tarMagicOffset = 257
+
+// Detect определяет формат архива по имени файла или его типу и проверяет
+// сигнатуру содержимого. По одной сигнатуре архив не распознается: DOCX, XLSX
+// и JAR тоже являются ZIP-файлами.
+func Detect(r io.ReaderAt, filename, contentType string) Format {
+	var claimed Format
+	name := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		claimed = FormatTarGz
+	case strings.HasSuffix(name, ".tar"):
+		claimed = FormatTar
+	case strings.HasSuffix(name, ".zip"):
+		claimed = FormatZip
+	default:
+		claimed = contentTypes[strings.ToLower(contentType)]
+	}
+	if claimed == FormatNone {
+		return FormatNone
+	}
+
+	if detected := detectContent(r); detected == claimed || claimed == FormatTarGz && detected == FormatTar {
+		return detected
+	}
+	return FormatNone
+}
+
+// contentTypes форматы архивов по типу содержимого, если имя файла ничего не говорит
+var contentTypes = map[string]Format{
+	"application/zip":              FormatZip,
+	"application/x-zip-compressed": FormatZip,
+	"application/x-tar":            FormatTar,
+	"application/gzip":             FormatTarGz,
+	"application/x-gzip":           FormatTarGz,
+	"application/x-compressed-tar": FormatTarGz,
+}
+
+// detectContent определяет формат архива по сигнатуре
+func detectContent(r io.ReaderAt) Format {
+	header := make([]byte, tarMagicOffset+len(tarMagic))
+	n, _ := r.ReadAt(header, 0)
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, zipMagic), bytes.HasPrefix(header, emptyZipMagic):
+		return FormatZip
+	case isTarHeader(header):
+		return FormatTar
+	case bytes.HasPrefix(header, gzipMagic):
+		// Сжатым может быть любой файл, архивом он считается, только если внутри tar
+		gz, err := gzip.NewReader(io.NewSectionReader(r, 0, 1<<62))
+		if err != nil {
+			return FormatNone
+		}
+		defer gz.Close()
+		inner := make([]byte, tarMagicOffset+len(tarMagic))
+		n, _ := io.ReadFull(gz, inner)
+		if isTarHeader(inner[:n]) {
+			return FormatTarGz
+		}
+	}
+	return FormatNone
+}
+
+func isTarHeader(header []byte) bool {
+	return len(header) >= tarMagicOffset+len(tarMagic) &&
+		bytes.Equal(header[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic)
+}
+
+// Walk вызывает fn для каждого обычного файла архива в порядке их следования.
+// Каталоги, ссылки и специальные файлы пропускаются. content действителен
+// только до возврата из fn.
+func Walk(r io.ReaderAt, size int64, format Format, limits Limits, fn func(member Member, content io.Reader) error) error {
+	w := &walker{limits: limits, fn: fn}
+
+	switch format {
+	case FormatZip:
+		return w.walkZip(r, size)
+	case FormatTar:
+		return w.walkTar(io.NewSectionReader(r, 0, size))
+	case FormatTarGz:
+		gz, err := gzip.NewReader(io.NewSectionReader(r, 0, size))
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+		defer gz.Close()
+		return w.walkTar(gz)
+	}
+	return fmt.Errorf("%w: unknown format %q", ErrInvalidArchive, format)
+}
+
+type walker struct {
+	limits   Limits
+	fn       func(member Member, content io.Reader) error
+	members  int
+	unpacked int64
+}
+
+func (w *walker) walkZip(r io.ReaderAt, size int64) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		// Зашифрованные файлы archive/zip прочитал бы как мусор
+		if f.Flags&0x1 != 0 {
+			return fmt.Errorf("%w: encrypted member %s", ErrInvalidArchive, f.Name)
+		}
+
+		content, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+		err = w.visit(f.Name, content)
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *walker) walkTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		if err := w.visit(header.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// visit проверяет путь и ограничения и передает файл в fn
+func (w *walker) visit(name string, content io.Reader) error {
+	memberPath, err := cleanPath(name)
+	if err != nil {
+		return err
+	}
+
+	w.members++
+	if w.members > w.limits.MaxMembers {
+		return fmt.Errorf("%w: more than %d", ErrTooManyMembers, w.limits.MaxMembers)
+	}
+
+	counted := &budgetReader{src: content, walker: w}
+	if err := w.fn(Member{Path: memberPath}, counted); err != nil {
+		return err
+	}
+	// Непрочитанный fn остаток тоже считается, иначе бомба прошла бы мимо лимита
+	_, err = io.Copy(io.Discard, counted)
+	return err
+}
+
+// cleanPath приводит путь файла в архиве к виду a/b/c и отклоняет пути,
+// указывающие за пределы архива
+func cleanPath(name string) (string, error) {
+	// В ZIP, созданных в Windows, разделитель бывает обратной косой чертой
+	slashed := strings.ReplaceAll(name, "\\", "/")
+	if strings.HasPrefix(slashed, "/") || hasDrive(slashed) {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+	}
+
+	cleaned := path.Clean(slashed)
+	if !fs.ValidPath(cleaned) || cleaned == "." {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+	}
+	return cleaned, nil
+}
+
+func hasDrive(name string) bool {
+	return len(name) >= 2 && name[1] == ':'
+}
+
+// budgetReader считает распакованные байты по всему архиву
+type budgetReader struct {
+	src    io.Reader
+	walker *walker
+}
+
+func (r *budgetReader) Read(p []byte) (int, error) {
+	n, err := r.src.Read(p)
+	r.walker.unpacked += int64(n)
+	if r.walker.unpacked > r.walker.limits.MaxUnpackedSize {
+		return n, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, r.walker.limits.MaxUnpackedSize)
+	}
+	if err != nil && err != io.EOF {
+		return n, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	return n, err
+}
@@ -0,0 +1,55 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"sd_hw3/pkg/config"
+)
+
+// Поддерживаемые хранилища
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// ErrNotFound объект с таким ключом отсутствует в хранилище
+var ErrNotFound = errors.New("blob not found")
+
+// Store хранилище содержимого файлов. Ключи - относительные пути через "/",
+// в БД (storage_path) сохраняется Location ключа, по которой видно хранилище.
+type Store interface {
+	// Name название хранилища (BackendLocal, BackendS3)
+	Name() string
+	// Put сохраняет содержимое src под ключом key и возвращает число записанных байт.
+	// Если чтение src завершилось ошибкой, объект не создается.
+	Put(ctx context.Context, key string, src io.Reader) (int64, error)
+	// Open открывает объект для чтения с произвольной позиции
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete удаляет объект; отсутствие объекта ошибкой не считается
+	Delete(ctx context.Context, key string) error
+	// Location возвращает ключ с указанием хранилища для storage_path
+	Location(key string) string
+	// Key извлекает ключ из storage_path; ok = false, если объект лежит в другом хранилище
+	Key(location string) (key string, ok bool)
+}
+
+// New создает хранилище, выбранное в cfg.StorageBackend
+func New(cfg config.Config) (Store, error) {
+	switch cfg.StorageBackend {
+	case BackendLocal, "":
+		return NewLocalStore(cfg.UploadDir)
+	case BackendS3:
+		return NewS3Store(S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			PathStyle:       cfg.S3PathStyle,
+		})
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+}
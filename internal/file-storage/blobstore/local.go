@@ -0,0 +1,108 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const localScheme = BackendLocal + "://"
+
+// localStore хранит объекты файлами в каталоге baseDir
+type localStore struct {
+	baseDir string
+}
+
+// NewLocalStore создает хранилище на локальном диске
+func NewLocalStore(baseDir string) (Store, error) {
+	// Создаем директорию для хранения если не существует
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+	return &localStore{baseDir: baseDir}, nil
+}
+
+func (s *localStore) Name() string {
+	return BackendLocal
+}
+
+func (s *localStore) Put(ctx context.Context, key string, src io.Reader) (size int64, err error) {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	// Содержимое пишется во временный файл и переименовывается только после
+	// успешной записи, поэтому по path никогда не лежит недописанный файл
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	size, err = io.Copy(tmp, src)
+	if err != nil {
+		return 0, err
+	}
+	if err = tmp.Sync(); err != nil {
+		return 0, fmt.Errorf("failed to save file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, fmt.Errorf("failed to save file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("failed to save file: %w", err)
+	}
+	return size, nil
+}
+
+func (s *localStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	file, err := os.Open(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	return file, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+func (s *localStore) Location(key string) string {
+	return localScheme + key
+}
+
+// Key понимает и пути к файлам внутри baseDir, которые сохранялись в
+// storage_path до появления хранилищ
+func (s *localStore) Key(location string) (string, bool) {
+	if key, ok := strings.CutPrefix(location, localScheme); ok {
+		return key, true
+	}
+	if strings.Contains(location, "://") {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(s.baseDir, location)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func (s *localStore) path(key string) string {
+	return filepath.Join(s.baseDir, filepath.FromSlash(key))
+}
@@ -15,6 +15,7 @@ func MapFileToUploadResponse(file *models.File, work *models.Work) filestorage.F
 		SizeBytes:   intPtr(int(file.SizeBytes)),
 		UploadedAt:  &file.UploadedAt,
 		StoragePath: &file.StoragePath,
+		Revision:    intPtr(file.Revision),
 	}
 }
 
@@ -33,6 +34,107 @@ func MapFileMetaToMetadata(filemeta *service.FileMetadata) filestorage.FileMetad
 		SizeBytes:    int64Ptr(filemeta.SizeBytes),
 		UploadedAt:   &filemeta.UploadedAt,
 		Checksum:     filemeta.ChecksumMD5, // Используем MD5
+		IsTemplate:   &filemeta.IsTemplate,
+		IsArchive:    &filemeta.IsArchive,
+		ParentFileId: filemeta.ParentFileID,
+		MemberPath:   filemeta.MemberPath,
+		Revision:     intPtr(filemeta.Revision),
+
+		IsCurrentRevision: &filemeta.IsCurrentRevision,
+	}
+}
+
+// MapRevisionToResponse конвертирует ревизию работы в WorkRevision API
+func MapRevisionToResponse(revision *service.WorkRevision) filestorage.WorkRevision {
+	files := make([]filestorage.FileMetadata, 0, len(revision.Files))
+	for _, file := range revision.Files {
+		files = append(files, MapFileMetaToMetadata(file))
+	}
+
+	return filestorage.WorkRevision{
+		WorkId:     revision.WorkID,
+		Revision:   revision.Revision,
+		IsCurrent:  revision.IsCurrent,
+		UploadedAt: revision.UploadedAt,
+		Files:      files,
+	}
+}
+
+// MapDiffToResponse конвертирует разницу ревизий в RevisionDiff API
+func MapDiffToResponse(diff *service.RevisionDiff) filestorage.RevisionDiff {
+	changes := make([]filestorage.FileChange, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		changes = append(changes, filestorage.FileChange{
+			Path:         change.Path,
+			Status:       filestorage.FileChangeStatus(change.Status),
+			FromFileId:   change.FromFileID,
+			ToFileId:     change.ToFileID,
+			LinesAdded:   change.LinesAdded,
+			LinesRemoved: change.LinesRemoved,
+			Diff:         change.Diff,
+			DiffSkipped:  change.DiffSkipped,
+		})
+	}
+
+	return filestorage.RevisionDiff{
+		WorkId:       diff.WorkID,
+		FromRevision: diff.FromRevision,
+		ToRevision:   diff.ToRevision,
+		Changes:      changes,
+	}
+}
+
+// MapUploadToResponse конвертирует модель UploadSession в UploadSession API
+func MapUploadToResponse(upload *models.UploadSession) filestorage.UploadSession {
+	return filestorage.UploadSession{
+		UploadId:     upload.UploadID,
+		StudentId:    stringPtr(upload.StudentID),
+		AssignmentId: stringPtr(upload.AssignmentID),
+		Filename:     stringPtr(upload.Filename),
+		SizeBytes:    upload.SizeBytes,
+		Offset:       upload.Offset,
+		ExpiresAt:    upload.ExpiresAt,
+	}
+}
+
+// MapWorkToResponse конвертирует модель Work в ответ API
+func MapWorkToResponse(work *models.Work) filestorage.Work {
+	return filestorage.Work{
+		WorkId:          work.WorkID,
+		StudentId:       work.StudentID,
+		AssignmentId:    work.AssignmentID,
+		IsTemplate:      work.IsTemplate,
+		LatestRevision:  work.LatestRevision,
+		CurrentRevision: work.CurrentRevision,
+		CreatedAt:       work.CreatedAt,
+	}
+}
+
+// MapPolicyToResponse конвертирует модель UploadPolicy в ответ API
+func MapPolicyToResponse(policy *models.UploadPolicy) filestorage.UploadPolicy {
+	return filestorage.UploadPolicy{
+		AssignmentId: policy.AssignmentID,
+		AllowedTypes: policy.AllowedTypes,
+		MaxFileSize:  policy.MaxFileSize,
+		CreatedAt:    policy.CreatedAt,
+		UpdatedAt:    policy.UpdatedAt,
+	}
+}
+
+// MapIdempotencyKeyToResponse конвертирует модель IdempotencyKey в ответ API
+func MapIdempotencyKeyToResponse(key *models.IdempotencyKey) filestorage.IdempotencyKey {
+	return filestorage.IdempotencyKey{
+		KeyId:          key.KeyID,
+		RequestHash:    key.RequestHash,
+		Status:         filestorage.IdempotencyKeyStatus(key.Status),
+		LockedUntil:    key.LockedUntil,
+		ResponseStatus: key.ResponseStatus,
+		ResponseBody:   key.ResponseBody,
+		WorkId:         key.WorkID,
+		FileId:         key.FileID,
+		ReportId:       key.ReportID,
+		CreatedAt:      key.CreatedAt,
+		ExpiresAt:      key.ExpiresAt,
 	}
 }
 
@@ -1,29 +1,21 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"strconv"
 
-	// "time"
-
 	filestorage "sd_hw3/api/generated/file-storage"
-	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/archive"
 	"sd_hw3/internal/file-storage/service"
 
 	"github.com/labstack/echo/v4"
 )
 
-// StorageService интерфейс для работы с хранилищем файлов
-type FileStorageService interface {
-	UploadFile(studentID, assignmentID string, fileData []byte, filename, contentType string, size int64) (*models.File, *models.Work, error)
-	GetFile(fileID string) (*models.File, error)
-	GetFileMetadata(fileID string) (*models.File, error)
-	GetFileContent(fileID string) ([]byte, error)
-	CheckFileExists(fileID string) (bool, error)
-}
-
 // Handler реализует ServerInterface из сгенерированного кода
 type Handler struct {
 	service service.StorageService
@@ -36,75 +28,51 @@ func NewHandler(service *service.StorageService) *Handler {
 	}
 }
 
-// UploadFile загружает файл
+// UploadFile загружает файл. Тело запроса читается потоково: поля формы
+// должны идти до части file, а содержимое файла пишется на диск, не
+// загружаясь в память целиком.
 func (h *Handler) UploadFile(ctx echo.Context) error {
-	fmt.Println("goyda!!!")
-	// Парсим multipart форму
-	form, err := ctx.MultipartForm()
+	fields, part, err := nextFilePart(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
-			Error:   stringPtr("INVALID_FORM"),
-			Message: stringPtr("Invalid multipart form data"),
-		})
+		return uploadFormError(ctx, err)
 	}
+	defer part.Close()
 
 	// Получаем поля формы
-	studentID := getFormValue(form, "student_id")
-	assignmentID := getFormValue(form, "assignment_id")
+	studentID := fields["student_id"]
+	assignmentID := fields["assignment_id"]
 
 	if studentID == "" || assignmentID == "" {
 		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
 			Error:   stringPtr("MISSING_REQUIRED_FIELDS"),
-			Message: stringPtr("student_id and assignment_id are required"),
-		})
-	}
-
-	// Получаем файл
-	files := form.File["file"]
-	if len(files) == 0 {
-		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
-			Error:   stringPtr("NO_FILE"),
-			Message: stringPtr("No file uploaded"),
+			Message: stringPtr("student_id and assignment_id are required and must precede the file"),
 		})
 	}
 
-	fileHeader := files[0]
-	if fileHeader.Size == 0 {
-		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
-			Error:   stringPtr("EMPTY_FILE"),
-			Message: stringPtr("File is empty"),
-		})
-	}
-
-	// Читаем файл
-	src, err := fileHeader.Open()
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
-			Error:   stringPtr("FILE_READ_ERROR"),
-			Message: stringPtr("Failed to read uploaded file"),
-		})
+	// Без ревизии файл создает новую ревизию работы
+	revision := 0
+	if value := fields["revision"]; value != "" {
+		revision, err = strconv.Atoi(value)
+		if err != nil || revision < 1 {
+			return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+				Error:   stringPtr("INVALID_REVISION"),
+				Message: stringPtr("revision must be a positive integer"),
+			})
+		}
 	}
-	defer src.Close()
-
-	// Здесь должна быть реализация чтения файла
-	// Для примера создаем пустой массив байт
-	fileData := make([]byte, fileHeader.Size)
 
 	// Вызываем сервис
 	fileModel, workModel, err := h.service.UploadFile(
 		ctx.Request().Context(),
 		studentID,
 		assignmentID,
-		fileData,
-		fileHeader.Filename,
-		fileHeader.Header.Get("Content-Type"),
-		fileHeader.Size,
+		part,
+		part.FileName(),
+		part.Header.Get("Content-Type"),
+		revision,
 	)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
-			Error:   stringPtr("UPLOAD_ERROR"),
-			Message: stringPtr(fmt.Sprintf("Failed to upload file: %v", err)),
-		})
+		return uploadError(ctx, err)
 	}
 
 	// Конвертируем в DTO
@@ -115,7 +83,12 @@ func (h *Handler) UploadFile(ctx echo.Context) error {
 
 // GetFile скачивает файл
 func (h *Handler) GetFile(ctx echo.Context, fileId string) error {
-	file, err := h.service.GetFile(ctx.Request().Context(), fileId)
+	return h.serveFile(ctx, fileId, true)
+}
+
+// GetFileMetadata получает метаданные файла
+func (h *Handler) GetFileMetadata(ctx echo.Context, fileId string) error {
+	file, err := h.service.GetFileMetadata(ctx.Request().Context(), fileId)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
 			Error:   stringPtr("FILE_NOT_FOUND"),
@@ -123,26 +96,13 @@ func (h *Handler) GetFile(ctx echo.Context, fileId string) error {
 		})
 	}
 
-	content, err := h.service.GetFileContent(ctx.Request().Context(), fileId)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
-			Error:   stringPtr("FILE_READ_ERROR"),
-			Message: stringPtr("Failed to read file content"),
-		})
-	}
-
-	// Устанавливаем заголовки
-	ctx.Response().Header().Set("Content-Type", *file.ContentType)
-	ctx.Response().Header().Set("Content-Length", strconv.FormatInt(file.SizeBytes, 10))
-	ctx.Response().Header().Set("Content-Disposition",
-		fmt.Sprintf("attachment; filename=\"%s\"", file.OriginalFilename))
-
-	return ctx.Blob(http.StatusOK, *file.ContentType, content)
+	metadata := MapFileMetaToMetadata(file)
+	return ctx.JSON(http.StatusOK, metadata)
 }
 
-// GetFileMetadata получает метаданные файла
-func (h *Handler) GetFileMetadata(ctx echo.Context, fileId string) error {
-	file, err := h.service.GetFileMetadata(ctx.Request().Context(), fileId)
+// ListArchiveMembers список файлов, распакованных из архива
+func (h *Handler) ListArchiveMembers(ctx echo.Context, fileId string) error {
+	members, err := h.service.GetArchiveMembers(ctx.Request().Context(), fileId)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
 			Error:   stringPtr("FILE_NOT_FOUND"),
@@ -150,12 +110,24 @@ func (h *Handler) GetFileMetadata(ctx echo.Context, fileId string) error {
 		})
 	}
 
-	metadata := MapFileMetaToMetadata(file)
-	return ctx.JSON(http.StatusOK, metadata)
+	response := make([]filestorage.FileMetadata, 0, len(members))
+	for _, member := range members {
+		response = append(response, MapFileMetaToMetadata(member))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string][]filestorage.FileMetadata{"files": response})
 }
 
 // GetFileContentInternal получает содержимое файла для внутреннего использования
 func (h *Handler) GetFileContentInternal(ctx echo.Context, fileId string) error {
+	return h.serveFile(ctx, fileId, false)
+}
+
+// serveFile отдает содержимое файла потоком с диска. Поддерживаются запросы
+// диапазонов (Range, 206) и условные запросы: ETag строится по SHA-256
+// содержимого, Last-Modified - по времени загрузки, поэтому If-None-Match и
+// If-Modified-Since получают 304, а прерванное скачивание можно продолжить.
+func (h *Handler) serveFile(ctx echo.Context, fileId string, attachment bool) error {
 	file, err := h.service.GetFile(ctx.Request().Context(), fileId)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
@@ -164,16 +136,32 @@ func (h *Handler) GetFileContentInternal(ctx echo.Context, fileId string) error
 		})
 	}
 
-	content, err := h.service.GetFileContent(ctx.Request().Context(), fileId)
+	content, err := h.service.OpenFileContent(ctx.Request().Context(), file)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
 			Error:   stringPtr("FILE_READ_ERROR"),
 			Message: stringPtr("Failed to read file content"),
 		})
 	}
+	defer content.Close()
 
-	ctx.Response().Header().Set("Content-Type", *file.ContentType)
-	return ctx.Blob(http.StatusOK, *file.ContentType, content)
+	// Устанавливаем заголовки
+	header := ctx.Response().Header()
+	contentType := "application/octet-stream"
+	if file.ContentType != nil && *file.ContentType != "" {
+		contentType = *file.ContentType
+	}
+	header.Set(echo.HeaderContentType, contentType)
+	if file.ChecksumSHA256 != nil {
+		header.Set("ETag", `"`+*file.ChecksumSHA256+`"`)
+	}
+	if attachment {
+		header.Set(echo.HeaderContentDisposition,
+			mime.FormatMediaType("attachment", map[string]string{"filename": file.OriginalFilename}))
+	}
+
+	http.ServeContent(ctx.Response(), ctx.Request(), file.OriginalFilename, file.UploadedAt, content)
+	return nil
 }
 
 func (h *Handler) CheckFileExists(ctx echo.Context, fileId string) error {
@@ -187,13 +175,95 @@ func (h *Handler) CheckFileExists(ctx echo.Context, fileId string) error {
 	return ctx.JSON(http.StatusOK, map[string][]string{"files": files})
 }
 
-// Вспомогательные функции
-func getFormValue(form *multipart.Form, key string) string {
-	values := form.Value[key]
-	if len(values) > 0 {
-		return values[0]
+// errNoFile в запросе нет части file
+var errNoFile = errors.New("no file uploaded")
+
+// maxFieldSize наибольший размер текстового поля формы
+const maxFieldSize = 4096
+
+// nextFilePart читает multipart-запрос до части file и возвращает ее вместе
+// с полями формы, встретившимися раньше. Содержимое файла остается непрочитанным.
+func nextFilePart(ctx echo.Context) (map[string]string, *multipart.Part, error) {
+	reader, err := ctx.Request().MultipartReader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, nil, errNoFile
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if part.FormName() == "file" {
+			return fields, part, nil
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, maxFieldSize))
+		part.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		fields[part.FormName()] = string(value)
+	}
+}
+
+// uploadFormError ответ на некорректный multipart-запрос
+func uploadFormError(ctx echo.Context, err error) error {
+	if errors.Is(err, errNoFile) {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("NO_FILE"),
+			Message: stringPtr("No file uploaded"),
+		})
+	}
+	return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+		Error:   stringPtr("INVALID_FORM"),
+		Message: stringPtr("Invalid multipart form data"),
+	})
+}
+
+// uploadError ответ на ошибку сохранения загруженного файла
+func uploadError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrEmptyFile):
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("EMPTY_FILE"),
+			Message: stringPtr("File is empty"),
+		})
+	case errors.Is(err, service.ErrFileTooLarge):
+		return ctx.JSON(http.StatusRequestEntityTooLarge, filestorage.ApiError{
+			Error:   stringPtr("FILE_TOO_LARGE"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, archive.ErrInvalidArchive):
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_ARCHIVE"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrTypeNotAllowed):
+		return ctx.JSON(http.StatusUnsupportedMediaType, filestorage.ApiError{
+			Error:   stringPtr("FILE_TYPE_NOT_ALLOWED"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrContentTypeMismatch):
+		return ctx.JSON(http.StatusUnsupportedMediaType, filestorage.ApiError{
+			Error:   stringPtr("CONTENT_TYPE_MISMATCH"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrRevisionNotLatest):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("REVISION_NOT_LATEST"),
+			Message: stringPtr(err.Error()),
+		})
 	}
-	return ""
+	return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
+		Error:   stringPtr("UPLOAD_ERROR"),
+		Message: stringPtr(fmt.Sprintf("Failed to upload file: %v", err)),
+	})
 }
 
 func stringPtr(s string) *string {
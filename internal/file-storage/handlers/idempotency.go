@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReserveIdempotencyKey занимает ключ идемпотентности запроса gateway
+func (h *Handler) ReserveIdempotencyKey(ctx echo.Context) error {
+	var request filestorage.ReserveIdempotencyKeyJSONRequestBody
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REQUEST"),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+
+	key, reserved, err := h.service.ReserveIdempotencyKey(ctx.Request().Context(), request.KeyId, request.RequestHash)
+	if err != nil {
+		return idempotencyError(ctx, err)
+	}
+
+	// Ключ занят этим запросом - gateway выполняет запрос; иначе запрос уже
+	// выполнен и возвращается его ответ
+	status := http.StatusOK
+	if reserved {
+		status = http.StatusCreated
+	}
+	return ctx.JSON(status, MapIdempotencyKeyToResponse(key))
+}
+
+// CompleteIdempotencyKey сохраняет ответ на запрос с ключом идемпотентности
+func (h *Handler) CompleteIdempotencyKey(ctx echo.Context, keyId filestorage.IdempotencyKeyId) error {
+	var request filestorage.CompleteIdempotencyKeyJSONRequestBody
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REQUEST"),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+
+	key := &models.IdempotencyKey{
+		KeyID:          keyId,
+		RequestHash:    request.RequestHash,
+		ResponseStatus: &request.ResponseStatus,
+		ResponseBody:   &request.ResponseBody,
+		WorkID:         request.WorkId,
+		FileID:         request.FileId,
+		ReportID:       request.ReportId,
+	}
+	if err := h.service.CompleteIdempotencyKey(ctx.Request().Context(), key); err != nil {
+		return idempotencyError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapIdempotencyKeyToResponse(key))
+}
+
+// ReleaseIdempotencyKey освобождает ключ запроса, который не удался
+func (h *Handler) ReleaseIdempotencyKey(ctx echo.Context, keyId filestorage.IdempotencyKeyId) error {
+	if err := h.service.ReleaseIdempotencyKey(ctx.Request().Context(), keyId); err != nil {
+		return idempotencyError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func idempotencyError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidIdempotencyKey):
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_IDEMPOTENCY_KEY"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrIdempotencyKeyNotFound):
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("IDEMPOTENCY_KEY_NOT_FOUND"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("IDEMPOTENCY_KEY_IN_PROGRESS"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrIdempotencyKeyMismatch):
+		return ctx.JSON(http.StatusUnprocessableEntity, filestorage.ApiError{
+			Error:   stringPtr("IDEMPOTENCY_KEY_MISMATCH"),
+			Message: stringPtr(err.Error()),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
+		Error:   stringPtr("IDEMPOTENCY_KEY_ERROR"),
+		Message: stringPtr(fmt.Sprintf("Failed to process idempotency key: %v", err)),
+	})
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetUploadPolicy получает ограничения загрузки задания
+func (h *Handler) GetUploadPolicy(ctx echo.Context, assignmentId string) error {
+	policy, err := h.service.GetUploadPolicy(ctx.Request().Context(), assignmentId)
+	if err != nil {
+		return policyError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapPolicyToResponse(policy))
+}
+
+// SetUploadPolicy создает или заменяет ограничения загрузки задания
+func (h *Handler) SetUploadPolicy(ctx echo.Context, assignmentId string) error {
+	var request filestorage.SetUploadPolicyJSONRequestBody
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REQUEST"),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+
+	policy := &models.UploadPolicy{
+		AssignmentID: assignmentId,
+		MaxFileSize:  request.MaxFileSize,
+	}
+	if request.AllowedTypes != nil {
+		policy.AllowedTypes = *request.AllowedTypes
+	}
+	if err := h.service.SetUploadPolicy(ctx.Request().Context(), policy); err != nil {
+		return policyError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapPolicyToResponse(policy))
+}
+
+// DeleteUploadPolicy удаляет ограничения загрузки задания
+func (h *Handler) DeleteUploadPolicy(ctx echo.Context, assignmentId string) error {
+	if err := h.service.DeleteUploadPolicy(ctx.Request().Context(), assignmentId); err != nil {
+		return policyError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func policyError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUploadPolicyNotFound):
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("UPLOAD_POLICY_NOT_FOUND"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrInvalidUploadPolicy):
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_UPLOAD_POLICY"),
+			Message: stringPtr(err.Error()),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
+		Error:   stringPtr("UPLOAD_POLICY_ERROR"),
+		Message: stringPtr(fmt.Sprintf("Failed to process upload policy: %v", err)),
+	})
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/internal/file-storage/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetWork получает работу: студента, задание и ревизии
+func (h *Handler) GetWork(ctx echo.Context, workId string) error {
+	work, err := h.service.GetWork(ctx.Request().Context(), workId)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapWorkToResponse(work))
+}
+
+// ListWorkRevisions список ревизий работы
+func (h *Handler) ListWorkRevisions(ctx echo.Context, workId string) error {
+	revisions, err := h.service.GetWorkRevisions(ctx.Request().Context(), workId)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+
+	response := make([]filestorage.WorkRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		response = append(response, MapRevisionToResponse(revision))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string][]filestorage.WorkRevision{"revisions": response})
+}
+
+// GetWorkRevision получает ревизию работы
+func (h *Handler) GetWorkRevision(ctx echo.Context, workId string, revision int) error {
+	workRevision, err := h.service.GetWorkRevision(ctx.Request().Context(), workId, revision)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapRevisionToResponse(workRevision))
+}
+
+// DeleteWorkRevision удаляет последнюю ревизию работы
+func (h *Handler) DeleteWorkRevision(ctx echo.Context, workId string, revision int) error {
+	if err := h.service.DeleteWorkRevision(ctx.Request().Context(), workId, revision); err != nil {
+		return revisionError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// DownloadWorkRevision скачивает ревизию: единственный файл как есть,
+// несколько файлов - ZIP-архивом
+func (h *Handler) DownloadWorkRevision(ctx echo.Context, workId string, revision int) error {
+	files, err := h.service.GetRevisionFiles(ctx.Request().Context(), workId, revision)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+	if len(files) == 1 {
+		return h.serveFile(ctx, files[0].FileID, true)
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "application/zip")
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment",
+		map[string]string{"filename": fmt.Sprintf("%s-r%d.zip", workId, revision)}))
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	// Заголовки уже отправлены, поэтому ошибку можно только залогировать:
+	// клиент получит оборванный архив
+	if err := h.service.WriteRevisionArchive(ctx.Request().Context(), files, ctx.Response()); err != nil {
+		fmt.Printf("Failed to stream revision %d of work %s: %v\n", revision, workId, err)
+	}
+	return nil
+}
+
+// SetCurrentRevision делает ревизию текущей
+func (h *Handler) SetCurrentRevision(ctx echo.Context, workId string) error {
+	var request filestorage.SetCurrentRevisionJSONRequestBody
+	if err := ctx.Bind(&request); err != nil || request.Revision < 1 {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REVISION"),
+			Message: stringPtr("revision must be a positive integer"),
+		})
+	}
+
+	revision, err := h.service.SetCurrentRevision(ctx.Request().Context(), workId, request.Revision)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapRevisionToResponse(revision))
+}
+
+// DiffWorkRevisions сравнивает две ревизии работы
+func (h *Handler) DiffWorkRevisions(ctx echo.Context, workId string, params filestorage.DiffWorkRevisionsParams) error {
+	if params.From < 1 || params.To < 1 {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REVISION"),
+			Message: stringPtr("from and to must be positive integers"),
+		})
+	}
+
+	diff, err := h.service.DiffRevisions(ctx.Request().Context(), workId, params.From, params.To)
+	if err != nil {
+		return revisionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, MapDiffToResponse(diff))
+}
+
+// revisionError ответ на ошибку получения ревизий
+func revisionError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrWorkNotFound):
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("WORK_NOT_FOUND"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrRevisionNotFound):
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("REVISION_NOT_FOUND"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrRevisionNotLatest):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("REVISION_NOT_LATEST"),
+			Message: stringPtr(err.Error()),
+		})
+	}
+	return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
+		Error:   stringPtr("REVISION_ERROR"),
+		Message: stringPtr(fmt.Sprintf("Failed to get revisions: %v", err)),
+	})
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListTemplates список шаблонных файлов задания
+func (h *Handler) ListTemplates(ctx echo.Context, assignmentId string) error {
+	templates, err := h.service.GetTemplates(ctx.Request().Context(), assignmentId)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, filestorage.ApiError{
+			Error:   stringPtr("FETCH_ERROR"),
+			Message: stringPtr(fmt.Sprintf("Failed to fetch templates: %v", err)),
+		})
+	}
+
+	response := make([]filestorage.FileMetadata, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, MapFileMetaToMetadata(template))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string][]filestorage.FileMetadata{"files": response})
+}
+
+// UploadTemplate загружает шаблонный файл задания
+func (h *Handler) UploadTemplate(ctx echo.Context, assignmentId string) error {
+	_, part, err := nextFilePart(ctx)
+	if err != nil {
+		return uploadFormError(ctx, err)
+	}
+	defer part.Close()
+
+	fileModel, workModel, err := h.service.UploadTemplate(
+		ctx.Request().Context(),
+		assignmentId,
+		part,
+		part.FileName(),
+		part.Header.Get("Content-Type"),
+	)
+	if err != nil {
+		return uploadError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, MapFileToUploadResponse(fileModel, workModel))
+}
+
+// DeleteTemplate удаляет шаблонный файл задания
+func (h *Handler) DeleteTemplate(ctx echo.Context, assignmentId string, fileId string) error {
+	if err := h.service.DeleteTemplate(ctx.Request().Context(), assignmentId, fileId); err != nil {
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("TEMPLATE_NOT_FOUND"),
+			Message: stringPtr(fmt.Sprintf("Template %s of assignment %s not found", fileId, assignmentId)),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Заголовки протокола загрузки частями (по образцу tus)
+const (
+	headerUploadOffset  = "Upload-Offset"
+	headerUploadLength  = "Upload-Length"
+	headerUploadExpires = "Upload-Expires"
+)
+
+// CreateUpload начинает загрузку файла частями
+func (h *Handler) CreateUpload(ctx echo.Context) error {
+	var req filestorage.CreateUploadRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("INVALID_REQUEST"),
+			Message: stringPtr("Invalid request body"),
+		})
+	}
+	if req.StudentId == "" || req.AssignmentId == "" || req.Filename == "" {
+		return ctx.JSON(http.StatusBadRequest, filestorage.ApiError{
+			Error:   stringPtr("MISSING_REQUIRED_FIELDS"),
+			Message: stringPtr("student_id, assignment_id and filename are required"),
+		})
+	}
+
+	contentType := ""
+	if req.ContentType != nil {
+		contentType = *req.ContentType
+	}
+
+	upload, err := h.service.CreateUpload(ctx.Request().Context(), req.StudentId, req.AssignmentId, req.Filename, contentType, req.SizeBytes)
+	if err != nil {
+		return uploadSessionError(ctx, err, "")
+	}
+
+	ctx.Response().Header().Set(echo.HeaderLocation, "/uploads/"+upload.UploadID)
+	setUploadHeaders(ctx, upload)
+	return ctx.JSON(http.StatusCreated, MapUploadToResponse(upload))
+}
+
+// GetUploadOffset сообщает, сколько байт загрузки уже принято
+func (h *Handler) GetUploadOffset(ctx echo.Context, uploadId string) error {
+	upload, err := h.service.GetUpload(ctx.Request().Context(), uploadId)
+	if err != nil {
+		if errors.Is(err, service.ErrUploadNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	setUploadHeaders(ctx, upload)
+	ctx.Response().Header().Set(headerUploadLength, strconv.FormatInt(upload.SizeBytes, 10))
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetUpload получает загрузку: студента, задание и принятый объем
+func (h *Handler) GetUpload(ctx echo.Context, uploadId string) error {
+	upload, err := h.service.GetUpload(ctx.Request().Context(), uploadId)
+	if err != nil {
+		return uploadSessionError(ctx, err, uploadId)
+	}
+
+	return ctx.JSON(http.StatusOK, MapUploadToResponse(upload))
+}
+
+// UploadChunk дописывает тело запроса в загрузку с места Upload-Offset
+func (h *Handler) UploadChunk(ctx echo.Context, uploadId string, params filestorage.UploadChunkParams) error {
+	upload, err := h.service.WriteChunk(ctx.Request().Context(), uploadId, params.UploadOffset, ctx.Request().Body)
+	if err != nil {
+		return uploadSessionError(ctx, err, uploadId)
+	}
+
+	setUploadHeaders(ctx, upload)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CancelUpload удаляет загрузку вместе с принятыми частями
+func (h *Handler) CancelUpload(ctx echo.Context, uploadId string) error {
+	if err := h.service.CancelUpload(ctx.Request().Context(), uploadId); err != nil {
+		return uploadSessionError(ctx, err, uploadId)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CompleteUpload сохраняет полностью принятый файл
+func (h *Handler) CompleteUpload(ctx echo.Context, uploadId string) error {
+	fileModel, workModel, err := h.service.CompleteUpload(ctx.Request().Context(), uploadId)
+	if err != nil {
+		return uploadSessionError(ctx, err, uploadId)
+	}
+
+	return ctx.JSON(http.StatusCreated, MapFileToUploadResponse(fileModel, workModel))
+}
+
+func setUploadHeaders(ctx echo.Context, upload *models.UploadSession) {
+	header := ctx.Response().Header()
+	header.Set(headerUploadOffset, strconv.FormatInt(upload.Offset, 10))
+	header.Set(headerUploadExpires, upload.ExpiresAt.UTC().Format(http.TimeFormat))
+}
+
+// uploadSessionError ответ на ошибку загрузки частями
+func uploadSessionError(ctx echo.Context, err error, uploadId string) error {
+	switch {
+	case errors.Is(err, service.ErrUploadNotFound):
+		return ctx.JSON(http.StatusNotFound, filestorage.ApiError{
+			Error:   stringPtr("UPLOAD_NOT_FOUND"),
+			Message: stringPtr(fmt.Sprintf("Upload %s not found or expired", uploadId)),
+		})
+	case errors.Is(err, service.ErrUploadOffsetMismatch):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("OFFSET_MISMATCH"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrUploadIncomplete):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("UPLOAD_INCOMPLETE"),
+			Message: stringPtr(err.Error()),
+		})
+	case errors.Is(err, service.ErrUploadCompleting):
+		return ctx.JSON(http.StatusConflict, filestorage.ApiError{
+			Error:   stringPtr("UPLOAD_IN_PROGRESS"),
+			Message: stringPtr(err.Error()),
+		})
+	}
+	return uploadError(ctx, err)
+}
@@ -3,11 +3,17 @@ package models
 import "time"
 
 type Work struct {
-	WorkID       string    `db:"work_id" json:"work_id"`
-	StudentID    string    `db:"student_id" json:"student_id"`
-	AssignmentID string    `db:"assignment_id" json:"assignment_id"`
-	CreatedAt    time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+	WorkID       string `db:"work_id" json:"work_id"`
+	StudentID    string `db:"student_id" json:"student_id"`
+	AssignmentID string `db:"assignment_id" json:"assignment_id"`
+	// IsTemplate работа преподавателя с шаблонными файлами задания
+	IsTemplate bool      `db:"is_template" json:"is_template"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+	// LatestRevision номер последней ревизии работы, 0 - файлов еще нет
+	LatestRevision int `db:"latest_revision" json:"latest_revision"`
+	// CurrentRevision ревизия, которая считается текущей; по умолчанию последняя
+	CurrentRevision int `db:"current_revision" json:"current_revision"`
 }
 
 type File struct {
@@ -21,4 +27,85 @@ type File struct {
 	ChecksumMD5      *string   `db:"checksum_md5" json:"checksum_md5,omitempty"`
 	ChecksumSHA256   *string   `db:"checksum_sha256" json:"checksum_sha256,omitempty"`
 	UploadedAt       time.Time `db:"uploaded_at" json:"uploaded_at"`
+	IsArchive        bool      `db:"is_archive" json:"is_archive"`
+	ParentFileID     *string   `db:"parent_file_id" json:"parent_file_id,omitempty"`
+	MemberPath       *string   `db:"member_path" json:"member_path,omitempty"`
+	Revision         int       `db:"revision" json:"revision"`
+}
+
+// Blob содержимое файла в хранилище, общее для всех файлов с одинаковой SHA-256
+type Blob struct {
+	ChecksumSHA256 string    `db:"checksum_sha256" json:"checksum_sha256"`
+	StoragePath    string    `db:"storage_path" json:"storage_path"`
+	SizeBytes      int64     `db:"size_bytes" json:"size_bytes"`
+	RefCount       int       `db:"ref_count" json:"ref_count"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+const (
+	// UploadOpen загрузка принимает части
+	UploadOpen = "open"
+	// UploadCompleting загрузка завершается или отменяется, части не принимаются
+	UploadCompleting = "completing"
+)
+
+// UploadSession незавершенная загрузка файла частями
+type UploadSession struct {
+	UploadID     string  `db:"upload_id" json:"upload_id"`
+	StudentID    string  `db:"student_id" json:"student_id"`
+	AssignmentID string  `db:"assignment_id" json:"assignment_id"`
+	Filename     string  `db:"filename" json:"filename"`
+	ContentType  *string `db:"content_type" json:"content_type,omitempty"`
+	SizeBytes    int64   `db:"size_bytes" json:"size_bytes"`
+	Offset       int64   `db:"offset_bytes" json:"offset"`
+	Status       string  `db:"status" json:"status"`
+	// ClaimedAt когда загрузку занял завершающий ее запрос
+	ClaimedAt *time.Time `db:"claimed_at" json:"claimed_at,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at" json:"updated_at"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+}
+
+// UploadChunk принятая часть загрузки, хранящаяся отдельным объектом
+type UploadChunk struct {
+	UploadID    string `db:"upload_id" json:"upload_id"`
+	Offset      int64  `db:"offset_bytes" json:"offset"`
+	SizeBytes   int64  `db:"size_bytes" json:"size_bytes"`
+	StoragePath string `db:"storage_path" json:"storage_path"`
+}
+
+// UploadPolicy ограничения загрузки файлов студентов по заданию
+type UploadPolicy struct {
+	AssignmentID string `db:"assignment_id" json:"assignment_id"`
+	// AllowedTypes допустимые типы содержимого вида type/subtype или type/*; пустой - любые
+	AllowedTypes []string `db:"allowed_types" json:"allowed_types"`
+	// MaxFileSize наибольший размер файла в байтах; nil - общий лимит сервиса
+	MaxFileSize *int64    `db:"max_file_size" json:"max_file_size,omitempty"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+const (
+	// IdempotencyKeyPending запрос с ключом выполняется
+	IdempotencyKeyPending = "pending"
+	// IdempotencyKeyCompleted запрос выполнен, ответ сохранен
+	IdempotencyKeyCompleted = "completed"
+)
+
+// IdempotencyKey ключ идемпотентности запроса клиента gateway и ответ на запрос
+type IdempotencyKey struct {
+	KeyID string `db:"key_id" json:"key_id"`
+	// RequestHash отпечаток запроса: повтор с тем же ключом должен совпадать с ним
+	RequestHash string `db:"request_hash" json:"request_hash"`
+	Status      string `db:"status" json:"status"`
+	// LockedUntil до какого времени запрос считается выполняемым; после этого
+	// незавершенный ключ можно занять снова
+	LockedUntil    *time.Time `db:"locked_until" json:"locked_until,omitempty"`
+	ResponseStatus *int       `db:"response_status" json:"response_status,omitempty"`
+	ResponseBody   *string    `db:"response_body" json:"response_body,omitempty"`
+	WorkID         *string    `db:"work_id" json:"work_id,omitempty"`
+	FileID         *string    `db:"file_id" json:"file_id,omitempty"`
+	ReportID       *string    `db:"report_id" json:"report_id,omitempty"`
+	CreatedAt      time.Time  `db:"created_at" json:"created_at"`
+	ExpiresAt      time.Time  `db:"expires_at" json:"expires_at"`
 }
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/pkg/db"
+)
+
+type BlobRepository interface {
+	// AddBlobRef добавляет ссылку на сохраненное содержимое; nil, если его еще нет
+	AddBlobRef(ctx context.Context, checksum string) (*models.Blob, error)
+	// SaveBlob сохраняет содержимое с одной ссылкой, а если оно уже сохранено
+	// параллельной загрузкой - добавляет ссылку
+	SaveBlob(ctx context.Context, blob *models.Blob) (*models.Blob, error)
+	// ReleaseBlob убирает ссылку файла с storagePath на содержимое. Когда ссылок
+	// не осталось, содержимое удаляется вызовом remove, пока запись заблокирована,
+	// и только затем удаляется запись: параллельная загрузка того же содержимого
+	// ждет блокировки и сохраняет его заново. Запись удаляется, даже если remove
+	// вернул ошибку, - ошибка возвращается вместе с записью. Возвращает nil, если
+	// файл не ссылается на общее содержимое (загружен до дедупликации).
+	ReleaseBlob(ctx context.Context, checksum, storagePath string, remove func(blob *models.Blob) error) (*models.Blob, error)
+}
+
+type blobRepository struct {
+	db *sql.DB
+}
+
+func NewBlobRepository() BlobRepository {
+	return &blobRepository{db: db.DB}
+}
+
+func (r *blobRepository) AddBlobRef(ctx context.Context, checksum string) (*models.Blob, error) {
+	query := `
+		UPDATE blobs SET ref_count = ref_count + 1
+		WHERE checksum_sha256 = $1
+		RETURNING checksum_sha256, storage_path, size_bytes, ref_count, created_at
+	`
+
+	blob, err := r.scanBlob(db.QueryRow(ctx, query, checksum))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return blob, err
+}
+
+func (r *blobRepository) SaveBlob(ctx context.Context, blob *models.Blob) (*models.Blob, error) {
+	query := `
+		INSERT INTO blobs (checksum_sha256, storage_path, size_bytes, ref_count, created_at)
+		VALUES ($1, $2, $3, 1, $4)
+		ON CONFLICT (checksum_sha256) DO UPDATE SET ref_count = blobs.ref_count + 1
+		RETURNING checksum_sha256, storage_path, size_bytes, ref_count, created_at
+	`
+
+	return r.scanBlob(db.QueryRow(ctx, query,
+		blob.ChecksumSHA256,
+		blob.StoragePath,
+		blob.SizeBytes,
+		blob.CreatedAt,
+	))
+}
+
+func (r *blobRepository) ReleaseBlob(ctx context.Context, checksum, storagePath string, remove func(blob *models.Blob) error) (*models.Blob, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Блокировка записи не дает параллельной загрузке добавить ссылку на
+	// содержимое, которое сейчас удаляется
+	query := `
+		SELECT checksum_sha256, storage_path, size_bytes, ref_count, created_at
+		FROM blobs
+		WHERE checksum_sha256 = $1 AND storage_path = $2
+		FOR UPDATE
+	`
+
+	blob, err := r.scanBlob(tx.QueryRowContext(ctx, query, checksum, storagePath))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blob.RefCount--
+	var removeErr error
+	if blob.RefCount > 0 {
+		_, err = tx.ExecContext(ctx, "UPDATE blobs SET ref_count = ref_count - 1 WHERE checksum_sha256 = $1", checksum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release blob: %w", err)
+		}
+	} else {
+		// Если объект не удалился, он остается без записи; загрузка того же
+		// содержимого перезапишет его
+		removeErr = remove(blob)
+		_, err = tx.ExecContext(ctx, "DELETE FROM blobs WHERE checksum_sha256 = $1", checksum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete blob: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return blob, removeErr
+}
+
+func (r *blobRepository) scanBlob(row *sql.Row) (*models.Blob, error) {
+	var blob models.Blob
+
+	err := row.Scan(
+		&blob.ChecksumSHA256,
+		&blob.StoragePath,
+		&blob.SizeBytes,
+		&blob.RefCount,
+		&blob.CreatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan blob: %w", err)
+	}
+
+	return &blob, nil
+}
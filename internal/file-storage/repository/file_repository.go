@@ -16,7 +16,15 @@ type FileRepository interface {
 	GetFileMetadata(ctx context.Context, fileID string) (*models.File, error)
 	DeleteFile(ctx context.Context, fileID string) error
 	UpdateFile(ctx context.Context, file *models.File) error
+	// GetFilesByChecksum ищет файлы других студентов с той же SHA-256:
+	// повторная загрузка студентом своей работы совпадением не считается
 	GetFilesByChecksum(ctx context.Context, checksum string, excludedFile string) ([]*models.File, error)
+	// GetTemplateFiles возвращает шаблонные файлы задания; вместо архивов - их содержимое
+	GetTemplateFiles(ctx context.Context, assignmentID string) ([]*models.File, error)
+	// CreateArchive сохраняет архив вместе с файлами из него
+	CreateArchive(ctx context.Context, archive *models.File, members []*models.File) error
+	// GetArchiveMembers возвращает файлы из архива, упорядоченные по пути
+	GetArchiveMembers(ctx context.Context, archiveFileID string) ([]*models.File, error)
 }
 
 type fileRepository struct {
@@ -27,16 +35,45 @@ func NewFileRepository() FileRepository {
 	return &fileRepository{db: db.DB}
 }
 
+const insertFileQuery = `
+	INSERT INTO files (
+		file_id, work_id, filename, original_filename, 
+		content_type, size_bytes, storage_path,
+		checksum_md5, checksum_sha256, uploaded_at,
+		is_archive, parent_file_id, member_path, revision
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+`
+
 func (r *fileRepository) CreateFile(ctx context.Context, file *models.File) error {
-	query := `
-		INSERT INTO files (
-			file_id, work_id, filename, original_filename, 
-			content_type, size_bytes, storage_path,
-			checksum_md5, checksum_sha256, uploaded_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-	`
+	_, err := db.Exec(ctx, insertFileQuery, fileArgs(file)...)
 
-	_, err := db.Exec(ctx, query,
+	return err
+}
+
+func (r *fileRepository) CreateArchive(ctx context.Context, archive *models.File, members []*models.File) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, insertFileQuery, fileArgs(archive)...); err != nil {
+		return fmt.Errorf("failed to create archive file: %w", err)
+	}
+	for _, member := range members {
+		if _, err := tx.ExecContext(ctx, insertFileQuery, fileArgs(member)...); err != nil {
+			return fmt.Errorf("failed to create archive member %s: %w", member.FileID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func fileArgs(file *models.File) []any {
+	return []any{
 		file.FileID,
 		file.WorkID,
 		file.Filename,
@@ -47,9 +84,11 @@ func (r *fileRepository) CreateFile(ctx context.Context, file *models.File) erro
 		file.ChecksumMD5,
 		file.ChecksumSHA256,
 		file.UploadedAt,
-	)
-
-	return err
+		file.IsArchive,
+		file.ParentFileID,
+		file.MemberPath,
+		file.Revision,
+	}
 }
 
 func (r *fileRepository) GetFileByID(ctx context.Context, fileID string) (*models.File, error) {
@@ -57,7 +96,8 @@ func (r *fileRepository) GetFileByID(ctx context.Context, fileID string) (*model
 		SELECT 
 			file_id, work_id, filename, original_filename,
 			content_type, size_bytes, storage_path,
-			checksum_md5, checksum_sha256, uploaded_at
+			checksum_md5, checksum_sha256, uploaded_at,
+			is_archive, parent_file_id, member_path, revision
 		FROM files
 		WHERE file_id = $1
 	`
@@ -77,10 +117,11 @@ func (r *fileRepository) GetFilesByWorkID(ctx context.Context, workID string) ([
 		SELECT 
 			file_id, work_id, filename, original_filename,
 			content_type, size_bytes, storage_path,
-			checksum_md5, checksum_sha256, uploaded_at
+			checksum_md5, checksum_sha256, uploaded_at,
+			is_archive, parent_file_id, member_path, revision
 		FROM files
 		WHERE work_id = $1
-		ORDER BY uploaded_at DESC
+		ORDER BY revision DESC, uploaded_at DESC
 	`
 
 	rows, err := db.Query(ctx, query, workID)
@@ -142,11 +183,20 @@ func (r *fileRepository) UpdateFile(ctx context.Context, file *models.File) erro
 func (r *fileRepository) GetFilesByChecksum(ctx context.Context, checksum string, excludeFileID string) ([]*models.File, error) {
 	query := `
 		SELECT 
-			file_id, work_id, filename, original_filename,
-			content_type, size_bytes, storage_path,
-			checksum_md5, checksum_sha256, uploaded_at
-		FROM files
-		WHERE checksum_md5 = $1 AND file_id != $2
+			f.file_id, f.work_id, f.filename, f.original_filename,
+			f.content_type, f.size_bytes, f.storage_path,
+			f.checksum_md5, f.checksum_sha256, f.uploaded_at,
+			f.is_archive, f.parent_file_id, f.member_path, f.revision
+		FROM files f
+		JOIN works w ON w.work_id = f.work_id
+		WHERE f.checksum_sha256 = $1 AND f.file_id != $2
+			AND NOT w.is_template
+			AND w.student_id != (
+				SELECT ew.student_id
+				FROM files ef
+				JOIN works ew ON ew.work_id = ef.work_id
+				WHERE ef.file_id = $2
+			)
 		LIMIT 1
 	`
 
@@ -167,6 +217,75 @@ func (r *fileRepository) GetFilesByChecksum(ctx context.Context, checksum string
 	return files, nil
 }
 
+func (r *fileRepository) GetTemplateFiles(ctx context.Context, assignmentID string) ([]*models.File, error) {
+	query := `
+		SELECT 
+			f.file_id, f.work_id, f.filename, f.original_filename,
+			f.content_type, f.size_bytes, f.storage_path,
+			f.checksum_md5, f.checksum_sha256, f.uploaded_at,
+			f.is_archive, f.parent_file_id, f.member_path, f.revision
+		FROM files f
+		JOIN works w ON w.work_id = f.work_id
+		WHERE w.assignment_id = $1 AND w.is_template AND NOT f.is_archive
+		ORDER BY f.uploaded_at
+	`
+
+	rows, err := db.Query(ctx, query, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query template files: %w", err)
+	}
+	defer rows.Close()
+
+	files := []*models.File{}
+	for rows.Next() {
+		file, err := r.scanFileFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return files, nil
+}
+
+func (r *fileRepository) GetArchiveMembers(ctx context.Context, archiveFileID string) ([]*models.File, error) {
+	query := `
+		SELECT 
+			file_id, work_id, filename, original_filename,
+			content_type, size_bytes, storage_path,
+			checksum_md5, checksum_sha256, uploaded_at,
+			is_archive, parent_file_id, member_path, revision
+		FROM files
+		WHERE parent_file_id = $1
+		ORDER BY member_path
+	`
+
+	rows, err := db.Query(ctx, query, archiveFileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive members: %w", err)
+	}
+	defer rows.Close()
+
+	files := []*models.File{}
+	for rows.Next() {
+		file, err := r.scanFileFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return files, nil
+}
+
 func (r *fileRepository) scanFile(row *sql.Row) (*models.File, error) {
 	var file models.File
 
@@ -181,6 +300,10 @@ func (r *fileRepository) scanFile(row *sql.Row) (*models.File, error) {
 		&file.ChecksumMD5,
 		&file.ChecksumSHA256,
 		&file.UploadedAt,
+		&file.IsArchive,
+		&file.ParentFileID,
+		&file.MemberPath,
+		&file.Revision,
 	)
 
 	if err == sql.ErrNoRows {
@@ -208,6 +331,10 @@ func (r *fileRepository) scanFileFromRows(rows *sql.Rows) (*models.File, error)
 		&file.ChecksumMD5,
 		&file.ChecksumSHA256,
 		&file.UploadedAt,
+		&file.IsArchive,
+		&file.ParentFileID,
+		&file.MemberPath,
+		&file.Revision,
 	)
 
 	if err != nil {
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/pkg/db"
+)
+
+type IdempotencyRepository interface {
+	// ReserveKey сохраняет новый ключ со статусом pending. Ключ, срок хранения
+	// которого истек, или незавершенный ключ с истекшей блокировкой занимается
+	// заново. Если ключ занят, возвращает его текущее состояние и false.
+	ReserveKey(ctx context.Context, key *models.IdempotencyKey) (*models.IdempotencyKey, bool, error)
+	// CompleteKey сохраняет ответ на запрос с ключом. Возвращает false, если
+	// ключа нет или он занят запросом с другим отпечатком.
+	CompleteKey(ctx context.Context, key *models.IdempotencyKey) (bool, error)
+	// ReleaseKey удаляет незавершенный ключ; false, если такого нет
+	ReleaseKey(ctx context.Context, keyID string) (bool, error)
+	// DeleteExpiredKeys удаляет ключи, срок хранения которых истек к моменту now
+	DeleteExpiredKeys(ctx context.Context, now time.Time) (int64, error)
+}
+
+type idempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository() IdempotencyRepository {
+	return &idempotencyRepository{db: db.DB}
+}
+
+const idempotencyColumns = `key_id, request_hash, status, locked_until, response_status,
+			response_body, work_id, file_id, report_id, created_at, expires_at`
+
+func (r *idempotencyRepository) ReserveKey(ctx context.Context, key *models.IdempotencyKey) (*models.IdempotencyKey, bool, error) {
+	// Обновление при конфликте срабатывает, только если прежний ключ больше не
+	// действует: иначе RETURNING не вернет строку
+	query := `
+		INSERT INTO idempotency_keys (key_id, request_hash, status, locked_until, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (key_id) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status = EXCLUDED.status,
+			locked_until = EXCLUDED.locked_until,
+			response_status = NULL,
+			response_body = NULL,
+			work_id = NULL,
+			file_id = NULL,
+			report_id = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < EXCLUDED.created_at
+			OR (idempotency_keys.status = '` + models.IdempotencyKeyPending + `'
+				AND idempotency_keys.locked_until < EXCLUDED.created_at)
+		RETURNING ` + idempotencyColumns
+
+	reserved, err := r.scanKey(db.QueryRow(ctx, query,
+		key.KeyID,
+		key.RequestHash,
+		key.Status,
+		key.LockedUntil,
+		key.CreatedAt,
+		key.ExpiresAt,
+	))
+	if err == nil {
+		return reserved, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	existing, err := r.scanKey(db.QueryRow(ctx, `
+		SELECT `+idempotencyColumns+`
+		FROM idempotency_keys
+		WHERE key_id = $1
+	`, key.KeyID))
+	if errors.Is(err, sql.ErrNoRows) {
+		// Ключ удалили между запросами: считаем, что он все еще занят
+		return &models.IdempotencyKey{KeyID: key.KeyID, RequestHash: key.RequestHash, Status: models.IdempotencyKeyPending}, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *idempotencyRepository) CompleteKey(ctx context.Context, key *models.IdempotencyKey) (bool, error) {
+	query := `
+		UPDATE idempotency_keys SET
+			status = $3,
+			locked_until = NULL,
+			response_status = $4,
+			response_body = $5,
+			work_id = $6,
+			file_id = $7,
+			report_id = $8,
+			expires_at = $9
+		WHERE key_id = $1 AND request_hash = $2
+	`
+
+	result, err := db.Exec(ctx, query,
+		key.KeyID,
+		key.RequestHash,
+		key.Status,
+		key.ResponseStatus,
+		key.ResponseBody,
+		key.WorkID,
+		key.FileID,
+		key.ReportID,
+		key.ExpiresAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *idempotencyRepository) ReleaseKey(ctx context.Context, keyID string) (bool, error) {
+	query := "DELETE FROM idempotency_keys WHERE key_id = $1 AND status = $2"
+
+	result, err := db.Exec(ctx, query, keyID, models.IdempotencyKeyPending)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *idempotencyRepository) DeleteExpiredKeys(ctx context.Context, now time.Time) (int64, error) {
+	result, err := db.Exec(ctx, "DELETE FROM idempotency_keys WHERE expires_at < $1", now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// scanKey читает ключ из *sql.Row
+func (r *idempotencyRepository) scanKey(row interface{ Scan(dest ...any) error }) (*models.IdempotencyKey, error) {
+	var key models.IdempotencyKey
+
+	err := row.Scan(
+		&key.KeyID,
+		&key.RequestHash,
+		&key.Status,
+		&key.LockedUntil,
+		&key.ResponseStatus,
+		&key.ResponseBody,
+		&key.WorkID,
+		&key.FileID,
+		&key.ReportID,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
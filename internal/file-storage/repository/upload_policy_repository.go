@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/pkg/db"
+
+	"github.com/lib/pq"
+)
+
+type UploadPolicyRepository interface {
+	// GetUploadPolicy возвращает nil, если для задания ограничения не заданы
+	GetUploadPolicy(ctx context.Context, assignmentID string) (*models.UploadPolicy, error)
+	// SaveUploadPolicy создает или заменяет ограничения задания
+	SaveUploadPolicy(ctx context.Context, policy *models.UploadPolicy) error
+	// DeleteUploadPolicy возвращает false, если ограничений для задания нет
+	DeleteUploadPolicy(ctx context.Context, assignmentID string) (bool, error)
+}
+
+type uploadPolicyRepository struct {
+	db *sql.DB
+}
+
+func NewUploadPolicyRepository() UploadPolicyRepository {
+	return &uploadPolicyRepository{db: db.DB}
+}
+
+func (r *uploadPolicyRepository) GetUploadPolicy(ctx context.Context, assignmentID string) (*models.UploadPolicy, error) {
+	query := `
+		SELECT assignment_id, allowed_types, max_file_size, created_at, updated_at
+		FROM upload_policies
+		WHERE assignment_id = $1
+	`
+
+	var policy models.UploadPolicy
+	err := db.QueryRow(ctx, query, assignmentID).Scan(
+		&policy.AssignmentID,
+		pq.Array(&policy.AllowedTypes),
+		&policy.MaxFileSize,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *uploadPolicyRepository) SaveUploadPolicy(ctx context.Context, policy *models.UploadPolicy) error {
+	// Время создания сохраняется при замене ограничений
+	query := `
+		INSERT INTO upload_policies (assignment_id, allowed_types, max_file_size, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (assignment_id) DO UPDATE
+		SET allowed_types = EXCLUDED.allowed_types,
+			max_file_size = EXCLUDED.max_file_size,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at
+	`
+
+	return db.QueryRow(ctx, query,
+		policy.AssignmentID,
+		pq.Array(policy.AllowedTypes),
+		policy.MaxFileSize,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+	).Scan(&policy.CreatedAt)
+}
+
+func (r *uploadPolicyRepository) DeleteUploadPolicy(ctx context.Context, assignmentID string) (bool, error) {
+	result, err := db.Exec(ctx, "DELETE FROM upload_policies WHERE assignment_id = $1", assignmentID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/pkg/db"
+)
+
+type UploadRepository interface {
+	CreateUpload(ctx context.Context, upload *models.UploadSession) error
+	// GetUpload возвращает загрузку или nil, если ее нет
+	GetUpload(ctx context.Context, uploadID string) (*models.UploadSession, error)
+	// AddChunk сохраняет часть, если смещение загрузки все еще равно chunk.Offset,
+	// и продлевает загрузку до expiresAt. Загрузка, занятая раньше staleBefore,
+	// снова открывается. Возвращает nil, если смещение изменилось, загрузка
+	// занята или ее уже нет.
+	AddChunk(ctx context.Context, chunk *models.UploadChunk, expiresAt, staleBefore time.Time) (*models.UploadSession, error)
+	// GetChunks части загрузки в порядке смещения
+	GetChunks(ctx context.Context, uploadID string) ([]*models.UploadChunk, error)
+	// ClaimUpload переводит неистекшую загрузку в статус UploadCompleting,
+	// если она открыта или занята раньше staleBefore (занявший ее запрос
+	// завис или сервис перезапустился). Срок загрузки продлевается не меньше
+	// чем до claimedUntil, чтобы ее не удалила очистка. Возвращает nil, если
+	// загрузку уже занял другой запрос или ее нет.
+	ClaimUpload(ctx context.Context, uploadID string, staleBefore, claimedUntil time.Time) (*models.UploadSession, error)
+	// ReopenUpload возвращает загрузку в статус UploadOpen, если она все еще
+	// занята запросом, занявшим ее в claimedAt
+	ReopenUpload(ctx context.Context, uploadID string, claimedAt time.Time) error
+	// DeleteUpload удаляет загрузку вместе с записями о ее частях
+	DeleteUpload(ctx context.Context, uploadID string) error
+	// GetExpiredUploads загрузки, истекшие к моменту now
+	GetExpiredUploads(ctx context.Context, now time.Time, limit int) ([]*models.UploadSession, error)
+}
+
+type uploadRepository struct {
+	db *sql.DB
+}
+
+func NewUploadRepository() UploadRepository {
+	return &uploadRepository{db: db.DB}
+}
+
+const uploadColumns = `upload_id, student_id, assignment_id, filename, content_type,
+			size_bytes, offset_bytes, status, claimed_at, created_at, updated_at, expires_at`
+
+func (r *uploadRepository) CreateUpload(ctx context.Context, upload *models.UploadSession) error {
+	query := `
+		INSERT INTO upload_sessions (
+			upload_id, student_id, assignment_id, filename, content_type,
+			size_bytes, offset_bytes, status, created_at, updated_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := db.Exec(ctx, query,
+		upload.UploadID,
+		upload.StudentID,
+		upload.AssignmentID,
+		upload.Filename,
+		upload.ContentType,
+		upload.SizeBytes,
+		upload.Offset,
+		upload.Status,
+		upload.CreatedAt,
+		upload.UpdatedAt,
+		upload.ExpiresAt,
+	)
+
+	return err
+}
+
+func (r *uploadRepository) GetUpload(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	query := `
+		SELECT ` + uploadColumns + `
+		FROM upload_sessions
+		WHERE upload_id = $1
+	`
+
+	upload, err := r.scanUpload(db.QueryRow(ctx, query, uploadID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return upload, err
+}
+
+func (r *uploadRepository) AddChunk(ctx context.Context, chunk *models.UploadChunk, expiresAt, staleBefore time.Time) (*models.UploadSession, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Условие на смещение вместе с блокировкой строки не дает двум
+	// параллельным запросам записать часть с одного и того же места, а
+	// условие на статус - дописать часть в завершаемую загрузку
+	query := `
+		UPDATE upload_sessions SET
+			offset_bytes = offset_bytes + $3,
+			updated_at = $4,
+			expires_at = $5,
+			status = 'open',
+			claimed_at = NULL
+		WHERE upload_id = $1 AND offset_bytes = $2
+			AND (status = 'open' OR claimed_at IS NULL OR claimed_at < $6)
+		RETURNING ` + uploadColumns
+
+	upload, err := r.scanUpload(tx.QueryRowContext(ctx, query,
+		chunk.UploadID,
+		chunk.Offset,
+		chunk.SizeBytes,
+		time.Now(),
+		expiresAt,
+		staleBefore,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chunkQuery := `
+		INSERT INTO upload_chunks (upload_id, offset_bytes, size_bytes, storage_path)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := tx.ExecContext(ctx, chunkQuery, chunk.UploadID, chunk.Offset, chunk.SizeBytes, chunk.StoragePath); err != nil {
+		return nil, fmt.Errorf("failed to insert upload chunk: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return upload, nil
+}
+
+func (r *uploadRepository) GetChunks(ctx context.Context, uploadID string) ([]*models.UploadChunk, error) {
+	query := `
+		SELECT upload_id, offset_bytes, size_bytes, storage_path
+		FROM upload_chunks
+		WHERE upload_id = $1
+		ORDER BY offset_bytes
+	`
+
+	rows, err := db.Query(ctx, query, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query upload chunks: %w", err)
+	}
+	defer rows.Close()
+
+	var chunks []*models.UploadChunk
+	for rows.Next() {
+		var chunk models.UploadChunk
+		if err := rows.Scan(&chunk.UploadID, &chunk.Offset, &chunk.SizeBytes, &chunk.StoragePath); err != nil {
+			return nil, fmt.Errorf("failed to scan upload chunk: %w", err)
+		}
+		chunks = append(chunks, &chunk)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return chunks, nil
+}
+
+func (r *uploadRepository) ClaimUpload(ctx context.Context, uploadID string, staleBefore, claimedUntil time.Time) (*models.UploadSession, error) {
+	// Условие на статус делает перевод атомарным: из параллельных запросов
+	// загрузку получает только один. Загрузка без claimed_at занята до его
+	// появления и считается брошенной.
+	query := `
+		UPDATE upload_sessions SET
+			status = 'completing',
+			claimed_at = $2,
+			updated_at = $2,
+			expires_at = GREATEST(expires_at, $4)
+		WHERE upload_id = $1 AND expires_at > $2
+			AND (status = 'open' OR claimed_at IS NULL OR claimed_at < $3)
+		RETURNING ` + uploadColumns
+
+	upload, err := r.scanUpload(db.QueryRow(ctx, query, uploadID, time.Now(), staleBefore, claimedUntil))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return upload, err
+}
+
+func (r *uploadRepository) ReopenUpload(ctx context.Context, uploadID string, claimedAt time.Time) error {
+	// Загрузку, которую после истечения занял другой запрос, не открываем
+	query := `
+		UPDATE upload_sessions SET status = 'open', claimed_at = NULL, updated_at = $3
+		WHERE upload_id = $1 AND status = 'completing' AND claimed_at = $2
+	`
+
+	_, err := db.Exec(ctx, query, uploadID, claimedAt, time.Now())
+	return err
+}
+
+func (r *uploadRepository) DeleteUpload(ctx context.Context, uploadID string) error {
+	query := "DELETE FROM upload_sessions WHERE upload_id = $1"
+
+	_, err := db.Exec(ctx, query, uploadID)
+	return err
+}
+
+func (r *uploadRepository) GetExpiredUploads(ctx context.Context, now time.Time, limit int) ([]*models.UploadSession, error) {
+	query := `
+		SELECT ` + uploadColumns + `
+		FROM upload_sessions
+		WHERE expires_at < $1
+		ORDER BY expires_at
+		LIMIT $2
+	`
+
+	rows, err := db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired uploads: %w", err)
+	}
+	defer rows.Close()
+
+	var uploads []*models.UploadSession
+	for rows.Next() {
+		upload, err := r.scanUpload(rows)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, upload)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return uploads, nil
+}
+
+// scanUpload читает загрузку из *sql.Row или *sql.Rows
+func (r *uploadRepository) scanUpload(row interface{ Scan(dest ...any) error }) (*models.UploadSession, error) {
+	var upload models.UploadSession
+
+	err := row.Scan(
+		&upload.UploadID,
+		&upload.StudentID,
+		&upload.AssignmentID,
+		&upload.Filename,
+		&upload.ContentType,
+		&upload.SizeBytes,
+		&upload.Offset,
+		&upload.Status,
+		&upload.ClaimedAt,
+		&upload.CreatedAt,
+		&upload.UpdatedAt,
+		&upload.ExpiresAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan upload: %w", err)
+	}
+
+	return &upload, nil
+}
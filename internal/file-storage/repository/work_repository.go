@@ -15,7 +15,15 @@ type WorkRepository interface {
 	CreateWork(ctx context.Context, work *models.Work) error
 	GetWorkByID(ctx context.Context, workID string) (*models.Work, error)
 	GetOrCreateWork(ctx context.Context, studentID, assignmentID string) (*models.Work, error)
+	// GetOrCreateTemplateWork возвращает работу, в которой хранятся шаблоны задания
+	GetOrCreateTemplateWork(ctx context.Context, assignmentID string) (*models.Work, error)
 	DeleteWork(ctx context.Context, workID string) error
+	// NextRevision увеличивает номер последней ревизии работы и возвращает его
+	NextRevision(ctx context.Context, workID string) (int, error)
+	// AdvanceCurrentRevision делает ревизию текущей, если текущая ревизия старше
+	AdvanceCurrentRevision(ctx context.Context, workID string, revision int) error
+	// SetCurrentRevision делает текущей любую ревизию, в том числе более старую
+	SetCurrentRevision(ctx context.Context, workID string, revision int) error
 }
 
 type workRepository struct {
@@ -28,14 +36,15 @@ func NewWorkRepository() WorkRepository {
 
 func (r *workRepository) CreateWork(ctx context.Context, work *models.Work) error {
 	query := `
-		INSERT INTO works (work_id, student_id, assignment_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO works (work_id, student_id, assignment_id, is_template, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
 	_, err := db.Exec(ctx, query,
 		work.WorkID,
 		work.StudentID,
 		work.AssignmentID,
+		work.IsTemplate,
 		work.CreatedAt,
 		work.UpdatedAt,
 	)
@@ -45,7 +54,8 @@ func (r *workRepository) CreateWork(ctx context.Context, work *models.Work) erro
 
 func (r *workRepository) GetWorkByID(ctx context.Context, workID string) (*models.Work, error) {
 	query := `
-		SELECT work_id, student_id, assignment_id, created_at, updated_at
+		SELECT work_id, student_id, assignment_id, is_template, created_at, updated_at,
+			latest_revision, current_revision
 		FROM works
 		WHERE work_id = $1
 	`
@@ -57,8 +67,11 @@ func (r *workRepository) GetWorkByID(ctx context.Context, workID string) (*model
 		&work.WorkID,
 		&work.StudentID,
 		&work.AssignmentID,
+		&work.IsTemplate,
 		&work.CreatedAt,
 		&work.UpdatedAt,
+		&work.LatestRevision,
+		&work.CurrentRevision,
 	)
 
 	if err == sql.ErrNoRows {
@@ -73,22 +86,35 @@ func (r *workRepository) GetWorkByID(ctx context.Context, workID string) (*model
 }
 
 func (r *workRepository) GetOrCreateWork(ctx context.Context, studentID, assignmentID string) (*models.Work, error) {
+	return r.getOrCreateWork(ctx, studentID, assignmentID, false)
+}
+
+func (r *workRepository) GetOrCreateTemplateWork(ctx context.Context, assignmentID string) (*models.Work, error) {
+	// У шаблонной работы нет студента
+	return r.getOrCreateWork(ctx, "", assignmentID, true)
+}
+
+func (r *workRepository) getOrCreateWork(ctx context.Context, studentID, assignmentID string, isTemplate bool) (*models.Work, error) {
 	// Сначала пытаемся найти существующую работу
 	query := `
-		SELECT work_id, student_id, assignment_id, created_at, updated_at
+		SELECT work_id, student_id, assignment_id, is_template, created_at, updated_at,
+			latest_revision, current_revision
 		FROM works
-		WHERE student_id = $1 AND assignment_id = $2
+		WHERE student_id = $1 AND assignment_id = $2 AND is_template = $3
 	`
 
-	row := db.QueryRow(ctx, query, studentID, assignmentID)
+	row := db.QueryRow(ctx, query, studentID, assignmentID, isTemplate)
 
 	var work models.Work
 	err := row.Scan(
 		&work.WorkID,
 		&work.StudentID,
 		&work.AssignmentID,
+		&work.IsTemplate,
 		&work.CreatedAt,
 		&work.UpdatedAt,
+		&work.LatestRevision,
+		&work.CurrentRevision,
 	)
 
 	if err == nil {
@@ -102,10 +128,15 @@ func (r *workRepository) GetOrCreateWork(ctx context.Context, studentID, assignm
 	}
 
 	// Работа не найдена, создаем новую
+	owner := studentID
+	if isTemplate {
+		owner = "template"
+	}
 	work = models.Work{
-		WorkID:       generateWorkID(studentID, assignmentID),
+		WorkID:       generateWorkID(owner, assignmentID),
 		StudentID:    studentID,
 		AssignmentID: assignmentID,
+		IsTemplate:   isTemplate,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
@@ -125,6 +156,49 @@ func (r *workRepository) DeleteWork(ctx context.Context, workID string) error {
 	return err
 }
 
+func (r *workRepository) NextRevision(ctx context.Context, workID string) (int, error) {
+	query := `
+		UPDATE works
+		SET latest_revision = latest_revision + 1, updated_at = $2
+		WHERE work_id = $1
+		RETURNING latest_revision
+	`
+
+	var revision int
+	err := db.QueryRow(ctx, query, workID, time.Now()).Scan(&revision)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("work not found: %s", workID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to take next revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (r *workRepository) AdvanceCurrentRevision(ctx context.Context, workID string, revision int) error {
+	// Параллельная загрузка более новой ревизии не должна откатываться назад
+	query := `
+		UPDATE works
+		SET current_revision = $2, updated_at = $3
+		WHERE work_id = $1 AND current_revision < $2
+	`
+
+	_, err := db.Exec(ctx, query, workID, revision, time.Now())
+	return err
+}
+
+func (r *workRepository) SetCurrentRevision(ctx context.Context, workID string, revision int) error {
+	query := `
+		UPDATE works
+		SET current_revision = $2, updated_at = $3
+		WHERE work_id = $1
+	`
+
+	_, err := db.Exec(ctx, query, workID, revision, time.Now())
+	return err
+}
+
 func generateWorkID(studentID, assignmentID string) string {
 	return fmt.Sprintf("work-%s-%s-%d", studentID, assignmentID, time.Now().UnixNano())
 }
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"sd_hw3/internal/file-storage/archive"
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/sniff"
+)
+
+// GetArchiveMembers получает метаданные файлов из архива
+func (s *StorageService) GetArchiveMembers(ctx context.Context, fileID string) ([]*FileMetadata, error) {
+	metadata, err := s.GetFileMetadata(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := s.fileRepo.GetArchiveMembers(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive members: %w", err)
+	}
+
+	members := make([]*FileMetadata, 0, len(files))
+	for _, file := range files {
+		member := fileMetadata(file)
+		member.StudentID = metadata.StudentID
+		member.AssignmentID = metadata.AssignmentID
+		member.IsTemplate = metadata.IsTemplate
+		member.IsCurrentRevision = metadata.IsCurrentRevision
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// unpackArchive сохраняет каждый файл архива с собственными контрольными
+// суммами. Пустые файлы (например, __init__.py) пропускаются: они совпадают у
+// всех и ничего не говорят о заимствованиях. Тип каждого файла должен быть
+// допустим по rules, иначе архив отклоняется целиком.
+func (s *StorageService) unpackArchive(ctx context.Context, r io.ReaderAt, size int64, format archive.Format, archiveID string, rules uploadRules) ([]*models.File, error) {
+	limits := archive.Limits{
+		MaxMembers:      s.config.ArchiveMaxMembers,
+		MaxUnpackedSize: s.config.ArchiveMaxUnpackedSize,
+	}
+
+	var members []*models.File
+	err := archive.Walk(r, size, format, limits, func(member archive.Member, content io.Reader) error {
+		file, err := s.storeMember(ctx, fmt.Sprintf("%s-%d", archiveID, len(members)+1), member, content, rules)
+		if err != nil || file == nil {
+			return err
+		}
+		members = append(members, file)
+		return nil
+	})
+	if err == nil && len(members) == 0 {
+		err = fmt.Errorf("%w: no non-empty files", archive.ErrInvalidArchive)
+	}
+	if err != nil {
+		s.releaseFiles(ctx, members)
+		return nil, err
+	}
+	return members, nil
+}
+
+// storeMember сохраняет содержимое файла из архива; nil, если файл пустой
+func (s *StorageService) storeMember(ctx context.Context, fileID string, member archive.Member, content io.Reader, rules uploadRules) (*models.File, error) {
+	spool, err := os.CreateTemp("", "archive-member-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
+
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(spool, md5Hash, sha256Hash), content)
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	md5Sum := hex.EncodeToString(md5Hash.Sum(nil))
+	sha256Sum := hex.EncodeToString(sha256Hash.Sum(nil))
+
+	// Типа от клиента у файлов архива нет, проверяется только список допустимых
+	contentType := sniff.Detect(spool, size, member.Path, "")
+	if err := s.checkAllowed(rules, member.Path, contentType); err != nil {
+		return nil, err
+	}
+
+	blob, err := s.acquireBlob(ctx, sha256Sum, size, spool)
+	if err != nil {
+		return nil, err
+	}
+	memberPath := member.Path
+
+	return &models.File{
+		FileID:           fileID,
+		Filename:         fileID,
+		OriginalFilename: path.Base(member.Path),
+		ContentType:      &contentType,
+		SizeBytes:        size,
+		StoragePath:      blob.StoragePath,
+		ChecksumMD5:      &md5Sum,
+		ChecksumSHA256:   &sha256Sum,
+		MemberPath:       &memberPath,
+	}, nil
+}
+
+// releaseFiles убирает ссылки файлов на содержимое, ошибки только логируются
+func (s *StorageService) releaseFiles(ctx context.Context, files []*models.File) {
+	for _, file := range files {
+		if file.ChecksumSHA256 == nil {
+			continue
+		}
+		if _, err := s.releaseBlob(ctx, *file.ChecksumSHA256, file.StoragePath); err != nil {
+			fmt.Printf("Failed to release content of file %s: %v\n", file.FileID, err)
+		}
+	}
+}
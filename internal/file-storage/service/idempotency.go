@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sd_hw3/internal/file-storage/models"
+)
+
+var (
+	// ErrInvalidIdempotencyKey некорректный ключ идемпотентности или ответ на запрос
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	// ErrIdempotencyKeyInProgress запрос с тем же ключом еще выполняется
+	ErrIdempotencyKeyInProgress = errors.New("idempotency key is in use by a request in progress")
+	// ErrIdempotencyKeyMismatch ключ уже использован с другим запросом
+	ErrIdempotencyKeyMismatch = errors.New("idempotency key was used with a different request")
+	// ErrIdempotencyKeyNotFound ключа нет, он истек или уже завершен другим запросом
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+)
+
+const (
+	// maxIdempotencyKeyLength совпадает с размером колонки key_id
+	maxIdempotencyKeyLength = 255
+	// requestHashLength длина SHA-256 в hex
+	requestHashLength = 64
+)
+
+// ReserveIdempotencyKey занимает ключ для запроса с отпечатком requestHash.
+// Возвращает true, если ключ занят этим вызовом и запрос нужно выполнить, и
+// false с сохраненным ответом, если запрос с этим ключом уже выполнен.
+func (s *StorageService) ReserveIdempotencyKey(ctx context.Context, keyID, requestHash string) (*models.IdempotencyKey, bool, error) {
+	if keyID == "" || len(keyID) > maxIdempotencyKeyLength {
+		return nil, false, fmt.Errorf("%w: key_id must be 1 to %d characters", ErrInvalidIdempotencyKey, maxIdempotencyKeyLength)
+	}
+	if len(requestHash) != requestHashLength {
+		return nil, false, fmt.Errorf("%w: request_hash must be a hex SHA-256", ErrInvalidIdempotencyKey)
+	}
+
+	now := time.Now()
+	lockedUntil := now.Add(s.config.IdempotencyLockTimeout)
+	key, reserved, err := s.keyRepo.ReserveKey(ctx, &models.IdempotencyKey{
+		KeyID:       keyID,
+		RequestHash: requestHash,
+		Status:      models.IdempotencyKeyPending,
+		LockedUntil: &lockedUntil,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.config.IdempotencyKeyTTL),
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return key, true, nil
+	}
+
+	switch {
+	case key.RequestHash != requestHash:
+		return nil, false, ErrIdempotencyKeyMismatch
+	case key.Status != models.IdempotencyKeyCompleted:
+		return nil, false, ErrIdempotencyKeyInProgress
+	}
+	return key, false, nil
+}
+
+// CompleteIdempotencyKey сохраняет ответ на запрос с ключом; срок хранения
+// ответа отсчитывается с этого момента
+func (s *StorageService) CompleteIdempotencyKey(ctx context.Context, key *models.IdempotencyKey) error {
+	if key.ResponseStatus == nil || key.ResponseBody == nil {
+		return fmt.Errorf("%w: response_status and response_body are required", ErrInvalidIdempotencyKey)
+	}
+
+	key.Status = models.IdempotencyKeyCompleted
+	key.LockedUntil = nil
+	key.ExpiresAt = time.Now().Add(s.config.IdempotencyKeyTTL)
+	completed, err := s.keyRepo.CompleteKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	if !completed {
+		return fmt.Errorf("%w: %s", ErrIdempotencyKeyNotFound, key.KeyID)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ запроса, который не удался, чтобы
+// клиент мог повторить его с тем же ключом
+func (s *StorageService) ReleaseIdempotencyKey(ctx context.Context, keyID string) error {
+	released, err := s.keyRepo.ReleaseKey(ctx, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	if !released {
+		return fmt.Errorf("%w: %s", ErrIdempotencyKeyNotFound, keyID)
+	}
+	return nil
+}
+
+// CleanupExpiredIdempotencyKeys удаляет истекшие ключи и возвращает их число
+func (s *StorageService) CleanupExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	return s.keyRepo.DeleteExpiredKeys(ctx, time.Now())
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/sniff"
+)
+
+var (
+	// ErrTypeNotAllowed тип содержимого не входит в список допустимых
+	ErrTypeNotAllowed = errors.New("file type not allowed")
+	// ErrContentTypeMismatch заявленный тип или расширение файла не совпадает с содержимым
+	ErrContentTypeMismatch = errors.New("content type mismatch")
+	// ErrInvalidUploadPolicy некорректные ограничения загрузки
+	ErrInvalidUploadPolicy = errors.New("invalid upload policy")
+	// ErrUploadPolicyNotFound для задания ограничения загрузки не заданы
+	ErrUploadPolicyNotFound = errors.New("upload policy not found")
+)
+
+// uploadRules ограничения одной загрузки. Общий список допустимых типов
+// сервиса (AllowedTypes) действует всегда, список задания сужает его.
+type uploadRules struct {
+	maxSize         int64
+	assignmentTypes []string
+}
+
+// GetUploadPolicy получает ограничения загрузки задания
+func (s *StorageService) GetUploadPolicy(ctx context.Context, assignmentID string) (*models.UploadPolicy, error) {
+	policy, err := s.policyRepo.GetUploadPolicy(ctx, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload policy: %w", err)
+	}
+	if policy == nil {
+		return nil, fmt.Errorf("%w: assignment %s", ErrUploadPolicyNotFound, assignmentID)
+	}
+	return policy, nil
+}
+
+// SetUploadPolicy создает или заменяет ограничения загрузки задания
+func (s *StorageService) SetUploadPolicy(ctx context.Context, policy *models.UploadPolicy) error {
+	allowed := make([]string, 0, len(policy.AllowedTypes))
+	for _, pattern := range policy.AllowedTypes {
+		if !sniff.ValidPattern(pattern) {
+			return fmt.Errorf("%w: %q is not a type/subtype or type/* pattern", ErrInvalidUploadPolicy, pattern)
+		}
+		allowed = append(allowed, strings.ToLower(strings.TrimSpace(pattern)))
+	}
+	if policy.MaxFileSize != nil && *policy.MaxFileSize < 1 {
+		return fmt.Errorf("%w: max_file_size must be positive", ErrInvalidUploadPolicy)
+	}
+
+	policy.AllowedTypes = allowed
+	policy.CreatedAt = time.Now()
+	policy.UpdatedAt = policy.CreatedAt
+	if err := s.policyRepo.SaveUploadPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("failed to save upload policy: %w", err)
+	}
+	return nil
+}
+
+// DeleteUploadPolicy удаляет ограничения загрузки задания
+func (s *StorageService) DeleteUploadPolicy(ctx context.Context, assignmentID string) error {
+	deleted, err := s.policyRepo.DeleteUploadPolicy(ctx, assignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete upload policy: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("%w: assignment %s", ErrUploadPolicyNotFound, assignmentID)
+	}
+	return nil
+}
+
+// rulesFor ограничения загрузки файла студента по заданию; лимит задания
+// не может превышать maxSize
+func (s *StorageService) rulesFor(ctx context.Context, assignmentID string, maxSize int64) (uploadRules, error) {
+	rules := uploadRules{maxSize: maxSize}
+
+	policy, err := s.policyRepo.GetUploadPolicy(ctx, assignmentID)
+	if err != nil {
+		return rules, fmt.Errorf("failed to get upload policy: %w", err)
+	}
+	if policy != nil {
+		if policy.MaxFileSize != nil && *policy.MaxFileSize < rules.maxSize {
+			rules.maxSize = *policy.MaxFileSize
+		}
+		rules.assignmentTypes = policy.AllowedTypes
+	}
+	return rules, nil
+}
+
+// checkContent сверяет определенный по содержимому тип с заявленным клиентом
+// и с расширением файла, а затем со списками допустимых типов
+func (s *StorageService) checkContent(rules uploadRules, filename, declared, detected string) error {
+	if !sniff.Compatible(declared, detected) {
+		return fmt.Errorf("%w: %s is sent as %s, but its content is %s", ErrContentTypeMismatch, filename, declared, detected)
+	}
+	if byExt := sniff.ExtensionType(filename); !sniff.Compatible(byExt, detected) {
+		return fmt.Errorf("%w: extension of %s means %s, but its content is %s", ErrContentTypeMismatch, filename, byExt, detected)
+	}
+	return s.checkAllowed(rules, filename, detected)
+}
+
+// checkAllowed проверяет тип по общему списку сервиса и списку задания
+func (s *StorageService) checkAllowed(rules uploadRules, filename, contentType string) error {
+	if !sniff.Allowed(s.config.AllowedTypes, contentType) || !sniff.Allowed(rules.assignmentTypes, contentType) {
+		return fmt.Errorf("%w: %s (%s)", ErrTypeNotAllowed, contentType, filename)
+	}
+	return nil
+}
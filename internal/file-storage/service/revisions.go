@@ -0,0 +1,456 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/textdiff"
+)
+
+var (
+	// ErrWorkNotFound работы нет
+	ErrWorkNotFound = errors.New("work not found")
+	// ErrRevisionNotFound в работе нет файлов с такой ревизией
+	ErrRevisionNotFound = errors.New("revision not found")
+	// ErrRevisionNotLatest файл добавляется не в последнюю ревизию работы
+	ErrRevisionNotLatest = errors.New("revision is not the latest")
+)
+
+const (
+	// maxDiffSize файлы больше этого размера сравниваются только по контрольной сумме
+	maxDiffSize = 1 << 20
+	// maxDiffEdits наибольшее число правок, для которого строится текстовая разница
+	maxDiffEdits = 2000
+	// diffContext число строк контекста вокруг изменений
+	diffContext = 3
+)
+
+// Статусы файла при сравнении ревизий
+const (
+	ChangeAdded     = "added"
+	ChangeRemoved   = "removed"
+	ChangeModified  = "modified"
+	ChangeUnchanged = "unchanged"
+)
+
+// WorkRevision файлы, загруженные в одну ревизию работы
+type WorkRevision struct {
+	WorkID    string
+	Revision  int
+	IsCurrent bool
+	// UploadedAt время загрузки первого файла ревизии
+	UploadedAt time.Time
+	// Files загруженные файлы; файлы из архивов доступны через GetArchiveMembers
+	Files []*FileMetadata
+}
+
+// RevisionDiff разница между двумя ревизиями работы
+type RevisionDiff struct {
+	WorkID       string
+	FromRevision int
+	ToRevision   int
+	Changes      []*FileChange
+}
+
+// FileChange изменение одного файла. Файлы из архивов сравниваются по пути
+// внутри архива, остальные - по имени.
+type FileChange struct {
+	Path       string
+	Status     string
+	FromFileID *string
+	ToFileID   *string
+	// LinesAdded, LinesRemoved и Diff заполняются для измененных текстовых файлов
+	LinesAdded   *int
+	LinesRemoved *int
+	Diff         *string
+	// DiffSkipped почему текстовая разница не построена
+	DiffSkipped *string
+}
+
+// takeRevision возвращает ревизию, в которую добавляется файл: новую, если
+// revision 0, иначе revision, если это последняя ревизия работы
+func (s *StorageService) takeRevision(ctx context.Context, work *models.Work, revision int) (int, error) {
+	if revision == 0 {
+		next, err := s.workRepo.NextRevision(ctx, work.WorkID)
+		if err != nil {
+			return 0, err
+		}
+		work.LatestRevision = next
+		return next, nil
+	}
+
+	if revision != work.LatestRevision {
+		return 0, fmt.Errorf("%w: work %s is at revision %d, got %d", ErrRevisionNotLatest, work.WorkID, work.LatestRevision, revision)
+	}
+	return revision, nil
+}
+
+func isCurrentRevision(work *models.Work, file *models.File) bool {
+	return file.Revision == work.CurrentRevision
+}
+
+// GetWork получает работу
+func (s *StorageService) GetWork(ctx context.Context, workID string) (*models.Work, error) {
+	work, err := s.workRepo.GetWorkByID(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWorkNotFound, err)
+	}
+	return work, nil
+}
+
+// GetWorkRevisions получает ревизии работы, начиная с последней
+func (s *StorageService) GetWorkRevisions(ctx context.Context, workID string) ([]*WorkRevision, error) {
+	work, files, err := s.workFiles(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []*WorkRevision
+	byNumber := make(map[int]*WorkRevision)
+	// Файлы упорядочены по убыванию ревизии
+	for _, file := range files {
+		if file.ParentFileID != nil {
+			continue
+		}
+		revision, ok := byNumber[file.Revision]
+		if !ok {
+			revision = &WorkRevision{
+				WorkID:     work.WorkID,
+				Revision:   file.Revision,
+				IsCurrent:  file.Revision == work.CurrentRevision,
+				UploadedAt: file.UploadedAt,
+			}
+			byNumber[file.Revision] = revision
+			revisions = append(revisions, revision)
+		}
+
+		metadata := fileMetadata(file)
+		metadata.StudentID = work.StudentID
+		metadata.AssignmentID = work.AssignmentID
+		metadata.IsTemplate = work.IsTemplate
+		metadata.IsCurrentRevision = revision.IsCurrent
+		revision.Files = append(revision.Files, metadata)
+		if file.UploadedAt.Before(revision.UploadedAt) {
+			revision.UploadedAt = file.UploadedAt
+		}
+	}
+
+	for _, revision := range revisions {
+		sort.Slice(revision.Files, func(i, j int) bool {
+			return revision.Files[i].UploadedAt.Before(revision.Files[j].UploadedAt)
+		})
+	}
+	return revisions, nil
+}
+
+// GetWorkRevision получает одну ревизию работы
+func (s *StorageService) GetWorkRevision(ctx context.Context, workID string, revision int) (*WorkRevision, error) {
+	revisions, err := s.GetWorkRevisions(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range revisions {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d of work %s", ErrRevisionNotFound, revision, workID)
+}
+
+// SetCurrentRevision делает ревизию текущей, например чтобы откатить работу
+// к более ранней версии. Следующая загрузка снова станет текущей.
+func (s *StorageService) SetCurrentRevision(ctx context.Context, workID string, revision int) (*WorkRevision, error) {
+	current, err := s.GetWorkRevision(ctx, workID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.workRepo.SetCurrentRevision(ctx, workID, revision); err != nil {
+		return nil, fmt.Errorf("failed to set current revision: %w", err)
+	}
+
+	current.IsCurrent = true
+	for _, file := range current.Files {
+		file.IsCurrentRevision = true
+	}
+	return current, nil
+}
+
+// DeleteWorkRevision удаляет файлы последней ревизии работы, например
+// отправки, которая не загрузилась целиком. Если ревизия была текущей,
+// текущей становится предыдущая ревизия с файлами. Номер ревизии повторно
+// не выдается.
+func (s *StorageService) DeleteWorkRevision(ctx context.Context, workID string, revision int) error {
+	work, files, err := s.workFiles(ctx, workID)
+	if err != nil {
+		return err
+	}
+	if revision != work.LatestRevision {
+		return fmt.Errorf("%w: work %s is at revision %d, got %d", ErrRevisionNotLatest, work.WorkID, work.LatestRevision, revision)
+	}
+
+	previous := 0
+	var deleted []*models.File
+	for _, file := range files {
+		switch {
+		case file.Revision == revision && file.ParentFileID == nil:
+			deleted = append(deleted, file)
+		case file.Revision < revision:
+			previous = max(previous, file.Revision)
+		}
+	}
+	if len(deleted) == 0 {
+		return fmt.Errorf("%w: %d of work %s", ErrRevisionNotFound, revision, workID)
+	}
+
+	// Текущая ревизия переводится до удаления файлов, чтобы работа не
+	// указывала на ревизию без файлов
+	if work.CurrentRevision == revision {
+		if err := s.workRepo.SetCurrentRevision(ctx, workID, previous); err != nil {
+			return fmt.Errorf("failed to set current revision: %w", err)
+		}
+	}
+
+	// Файлы из архивов удаляются вместе с архивом
+	for _, file := range deleted {
+		if err := s.DeleteFile(ctx, file.FileID); err != nil {
+			return fmt.Errorf("failed to delete file %s: %w", file.FileID, err)
+		}
+	}
+	return nil
+}
+
+// GetRevisionFiles получает загруженные в ревизию файлы (без файлов из архивов)
+func (s *StorageService) GetRevisionFiles(ctx context.Context, workID string, revision int) ([]*models.File, error) {
+	_, files, err := s.workFiles(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploaded []*models.File
+	for _, file := range files {
+		if file.Revision == revision && file.ParentFileID == nil {
+			uploaded = append(uploaded, file)
+		}
+	}
+	if len(uploaded) == 0 {
+		return nil, fmt.Errorf("%w: %d of work %s", ErrRevisionNotFound, revision, workID)
+	}
+
+	sort.Slice(uploaded, func(i, j int) bool {
+		return uploaded[i].UploadedAt.Before(uploaded[j].UploadedAt)
+	})
+	return uploaded, nil
+}
+
+// WriteRevisionArchive записывает файлы в ZIP-архив; одинаковые имена
+// получают суффикс " (2)", " (3)" и т.д.
+func (s *StorageService) WriteRevisionArchive(ctx context.Context, files []*models.File, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	names := make(map[string]bool)
+
+	for _, file := range files {
+		entry, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     uniqueName(names, path.Base(file.OriginalFilename)),
+			Method:   zip.Deflate,
+			Modified: file.UploadedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s to archive: %w", file.FileID, err)
+		}
+
+		content, err := s.OpenFileContent(ctx, file)
+		if err != nil {
+			return fmt.Errorf("failed to open file %s: %w", file.FileID, err)
+		}
+		_, err = io.Copy(entry, content)
+		content.Close()
+		if err != nil {
+			return fmt.Errorf("failed to write file %s to archive: %w", file.FileID, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+// DiffRevisions сравнивает содержимое двух ревизий работы
+func (s *StorageService) DiffRevisions(ctx context.Context, workID string, from, to int) (*RevisionDiff, error) {
+	work, files, err := s.workFiles(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromFiles, err := revisionContents(files, work.WorkID, from)
+	if err != nil {
+		return nil, err
+	}
+	toFiles, err := revisionContents(files, work.WorkID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(fromFiles)+len(toFiles))
+	for p := range fromFiles {
+		paths = append(paths, p)
+	}
+	for p := range toFiles {
+		if _, ok := fromFiles[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	diff := &RevisionDiff{WorkID: work.WorkID, FromRevision: from, ToRevision: to}
+	for _, p := range paths {
+		fromFile, toFile := fromFiles[p], toFiles[p]
+		change := &FileChange{Path: p}
+
+		switch {
+		case fromFile == nil:
+			change.Status = ChangeAdded
+		case toFile == nil:
+			change.Status = ChangeRemoved
+		case sameContent(fromFile, toFile):
+			change.Status = ChangeUnchanged
+		default:
+			change.Status = ChangeModified
+			if err := s.diffContent(ctx, change, fromFile, toFile); err != nil {
+				return nil, err
+			}
+		}
+		if fromFile != nil {
+			change.FromFileID = &fromFile.FileID
+		}
+		if toFile != nil {
+			change.ToFileID = &toFile.FileID
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+	return diff, nil
+}
+
+// diffContent строит построчную разницу измененного файла, если оба варианта
+// текстовые и не слишком большие
+func (s *StorageService) diffContent(ctx context.Context, change *FileChange, from, to *models.File) error {
+	skip := func(reason string) error {
+		change.DiffSkipped = &reason
+		return nil
+	}
+
+	if from.SizeBytes > maxDiffSize || to.SizeBytes > maxDiffSize {
+		return skip(fmt.Sprintf("file is larger than %d bytes", maxDiffSize))
+	}
+	fromText, err := s.readText(ctx, from)
+	if err != nil {
+		return err
+	}
+	toText, err := s.readText(ctx, to)
+	if err != nil {
+		return err
+	}
+	if fromText == nil || toText == nil {
+		return skip("binary file")
+	}
+
+	edits, err := textdiff.Diff(textdiff.Lines(*fromText), textdiff.Lines(*toText), maxDiffEdits)
+	if errors.Is(err, textdiff.ErrTooManyChanges) {
+		return skip(fmt.Sprintf("more than %d changed lines", maxDiffEdits))
+	}
+	if err != nil {
+		return err
+	}
+
+	added, removed := textdiff.Stats(edits)
+	unified := textdiff.Unified(
+		fmt.Sprintf("r%d/%s", from.Revision, change.Path),
+		fmt.Sprintf("r%d/%s", to.Revision, change.Path),
+		edits, diffContext)
+	change.LinesAdded = &added
+	change.LinesRemoved = &removed
+	change.Diff = &unified
+	return nil
+}
+
+// readText читает содержимое файла; nil, если это не текст в UTF-8
+func (s *StorageService) readText(ctx context.Context, file *models.File) (*string, error) {
+	content, err := s.OpenFileContent(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", file.FileID, err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(io.LimitReader(content, maxDiffSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", file.FileID, err)
+	}
+	if !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0 {
+		return nil, nil
+	}
+	text := string(data)
+	return &text, nil
+}
+
+// workFiles получает работу и все ее файлы
+func (s *StorageService) workFiles(ctx context.Context, workID string) (*models.Work, []*models.File, error) {
+	work, err := s.workRepo.GetWorkByID(ctx, workID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrWorkNotFound, err)
+	}
+	files, err := s.fileRepo.GetFilesByWorkID(ctx, workID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get work files: %w", err)
+	}
+	return work, files, nil
+}
+
+// revisionContents файлы ревизии по путям: вместо архивов - их содержимое
+func revisionContents(files []*models.File, workID string, revision int) (map[string]*models.File, error) {
+	found := false
+	contents := make(map[string]*models.File)
+	names := make(map[string]bool)
+	for _, file := range files {
+		if file.Revision != revision {
+			continue
+		}
+		found = true
+		if file.IsArchive {
+			continue
+		}
+
+		name := file.OriginalFilename
+		if file.MemberPath != nil {
+			name = *file.MemberPath
+		}
+		contents[uniqueName(names, name)] = file
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: %d of work %s", ErrRevisionNotFound, revision, workID)
+	}
+	return contents, nil
+}
+
+func sameContent(a, b *models.File) bool {
+	return a.ChecksumSHA256 != nil && b.ChecksumSHA256 != nil && *a.ChecksumSHA256 == *b.ChecksumSHA256
+}
+
+// uniqueName добавляет к повторяющемуся имени номер перед расширением
+func uniqueName(used map[string]bool, name string) string {
+	unique := name
+	ext := path.Ext(name)
+	for n := 2; used[unique]; n++ {
+		unique = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), n, ext)
+	}
+	used[unique] = true
+	return unique
+}
@@ -5,22 +5,37 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
 	"time"
 
+	"sd_hw3/internal/file-storage/archive"
+	"sd_hw3/internal/file-storage/blobstore"
 	"sd_hw3/internal/file-storage/models"
 	"sd_hw3/internal/file-storage/repository"
+	"sd_hw3/internal/file-storage/sniff"
 	"sd_hw3/pkg/config"
 )
 
+var (
+	// ErrFileTooLarge размер файла превышает MaxUploadSize
+	ErrFileTooLarge = errors.New("file too large")
+	// ErrEmptyFile загружен пустой файл
+	ErrEmptyFile = errors.New("file is empty")
+)
+
 // StorageService реализация интерфейса работы с хранилищем
 type StorageService struct {
-	config         config.Config
-	workRepo       repository.WorkRepository
-	fileRepo       repository.FileRepository
-	storageBaseDir string
+	config     config.Config
+	workRepo   repository.WorkRepository
+	fileRepo   repository.FileRepository
+	blobRepo   repository.BlobRepository
+	uploadRepo repository.UploadRepository
+	policyRepo repository.UploadPolicyRepository
+	keyRepo    repository.IdempotencyRepository
+	store      blobstore.Store
 }
 
 type FileMetadata struct {
@@ -33,50 +48,170 @@ type FileMetadata struct {
 	AssignmentID string
 	UploadedAt   time.Time
 	ChecksumMD5  *string
+	IsTemplate   bool
+	IsArchive    bool
+	ParentFileID *string
+	MemberPath   *string
+	Revision     int
+	// IsCurrentRevision файл относится к текущей ревизии своей работы
+	IsCurrentRevision bool
 }
 
 // NewStorageService создает новый сервис
 func NewStorageService(config config.Config) (*StorageService, error) {
-	// Создаем директорию для хранения если не существует
-	if err := os.MkdirAll(config.UploadDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	// Хранилище содержимого файлов выбирается конфигурацией
+	store, err := blobstore.New(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob store: %w", err)
 	}
 
 	return &StorageService{
-		config:         config,
-		workRepo:       repository.NewWorkRepository(),
-		fileRepo:       repository.NewFileRepository(),
-		storageBaseDir: config.UploadDir,
+		config:     config,
+		workRepo:   repository.NewWorkRepository(),
+		fileRepo:   repository.NewFileRepository(),
+		blobRepo:   repository.NewBlobRepository(),
+		uploadRepo: repository.NewUploadRepository(),
+		policyRepo: repository.NewUploadPolicyRepository(),
+		keyRepo:    repository.NewIdempotencyRepository(),
+		store:      store,
 	}, nil
 }
 
-// UploadFile загружает файл
-func (s *StorageService) UploadFile(ctx context.Context, studentID, assignmentID string, fileData []byte, filename, contentType string, size int64) (*models.File, *models.Work, error) {
-	// Получаем или создаем работу
-	work, err := s.workRepo.GetOrCreateWork(ctx, studentID, assignmentID)
+// UploadFile загружает файл, читая содержимое из src. revision 0 создает новую
+// ревизию работы, иначе файл добавляется в указанную ревизию, которая должна
+// быть последней.
+func (s *StorageService) UploadFile(ctx context.Context, studentID, assignmentID string, src io.Reader, filename, contentType string, revision int) (*models.File, *models.Work, error) {
+	return s.uploadFile(ctx, studentID, assignmentID, src, s.config.MaxUploadSize, filename, contentType, revision)
+}
+
+// uploadFile загружает файл студента размером не больше limit байт с учетом
+// ограничений загрузки задания
+func (s *StorageService) uploadFile(ctx context.Context, studentID, assignmentID string, src io.Reader, limit int64, filename, contentType string, revision int) (*models.File, *models.Work, error) {
+	rules, err := s.rulesFor(ctx, assignmentID, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.upload(ctx, src, rules, filename, contentType, revision, func() (*models.Work, error) {
+		// Получаем или создаем работу
+		work, err := s.workRepo.GetOrCreateWork(ctx, studentID, assignmentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get or create work: %w", err)
+		}
+		return work, nil
+	})
+}
+
+// UploadTemplate загружает шаблонный файл задания, читая содержимое из src
+func (s *StorageService) UploadTemplate(ctx context.Context, assignmentID string, src io.Reader, filename, contentType string) (*models.File, *models.Work, error) {
+	// Ограничения задания относятся к работам студентов, а не к шаблонам
+	rules := uploadRules{maxSize: s.config.MaxUploadSize}
+	return s.upload(ctx, src, rules, filename, contentType, 0, func() (*models.Work, error) {
+		work, err := s.workRepo.GetOrCreateTemplateWork(ctx, assignmentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get or create template work: %w", err)
+		}
+		return work, nil
+	})
+}
+
+// GetTemplates получает шаблонные файлы задания
+func (s *StorageService) GetTemplates(ctx context.Context, assignmentID string) ([]*FileMetadata, error) {
+	files, err := s.fileRepo.GetTemplateFiles(ctx, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template files: %w", err)
+	}
+
+	templates := make([]*FileMetadata, 0, len(files))
+	for _, file := range files {
+		template := fileMetadata(file)
+		template.AssignmentID = assignmentID
+		template.IsTemplate = true
+		// Действуют все загруженные шаблоны, а не только последняя ревизия
+		template.IsCurrentRevision = true
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// DeleteTemplate удаляет шаблонный файл задания
+func (s *StorageService) DeleteTemplate(ctx context.Context, assignmentID, fileID string) error {
+	file, err := s.fileRepo.GetFileByID(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("file not found: %w", err)
+	}
+	work, err := s.workRepo.GetWorkByID(ctx, file.WorkID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get or create work: %w", err)
+		return fmt.Errorf("failed to get work for file: %w", err)
+	}
+	if !work.IsTemplate || work.AssignmentID != assignmentID || file.ParentFileID != nil {
+		return fmt.Errorf("file not found: %s is not a template of assignment %s", fileID, assignmentID)
 	}
 
+	return s.DeleteFile(ctx, fileID)
+}
+
+// upload сохраняет содержимое в хранилище, затем получает работу через getWork и
+// сохраняет метаданные файла в БД. Работа запрашивается после записи файла,
+// чтобы отклоненная загрузка не оставляла пустых работ. Файлы из архивов
+// (ZIP, TAR, TAR.GZ) сохраняются как дочерние записи архива. Файл попадает в
+// ревизию revision или, если она 0, в новую ревизию работы. Тип содержимого
+// определяется по сигнатуре и проверяется по rules; в БД сохраняется он, а
+// не тип, заявленный клиентом.
+func (s *StorageService) upload(ctx context.Context, src io.Reader, rules uploadRules, filename, contentType string, revision int, getWork func() (*models.Work, error)) (*models.File, *models.Work, error) {
 	// Генерируем уникальный ID файла
 	fileID := generateFileID()
 
-	// Вычисляем контрольные суммы
-	md5Hash := calculateMD5(fileData)
-	sha256Hash := calculateSHA256(fileData)
+	// Содержимое сначала пишется во временный файл: ключ в хранилище - SHA-256
+	// содержимого, и он известен только после чтения всей загрузки
+	spool, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
 
-	// Создаем путь для хранения (используем поддиректории по первым 2 символам ID)
-	storageDir := filepath.Join(s.storageBaseDir, fileID[:2])
-	storagePath := filepath.Join(storageDir, fileID)
+	// Вычисляем контрольные суммы при записи
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(spool, md5Hash, sha256Hash), &limitedUpload{src: src, limit: rules.maxSize})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to save file: %w", err)
+	}
+	md5Sum := hex.EncodeToString(md5Hash.Sum(nil))
+	sha256Sum := hex.EncodeToString(sha256Hash.Sum(nil))
 
-	// Создаем поддиректорию если нужно
-	if err := os.MkdirAll(storageDir, 0755); err != nil {
-		return nil, nil, fmt.Errorf("failed to create storage directory: %w", err)
+	detected := sniff.Detect(spool, size, filename, contentType)
+	if err := s.checkContent(rules, filename, contentType, detected); err != nil {
+		return nil, nil, err
 	}
+	contentType = detected
 
-	// Сохраняем файл на диск
-	if err := os.WriteFile(storagePath, fileData, 0644); err != nil {
-		return nil, nil, fmt.Errorf("failed to save file: %w", err)
+	blob, err := s.acquireBlob(ctx, sha256Sum, size, spool)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var members []*models.File
+	format := archive.Detect(spool, filename, contentType)
+	if format != archive.FormatNone {
+		members, err = s.unpackArchive(ctx, spool, size, format, fileID, rules)
+		if err != nil {
+			s.releaseBlob(ctx, sha256Sum, blob.StoragePath)
+			return nil, nil, err
+		}
+	}
+
+	work, err := getWork()
+	if err == nil {
+		revision, err = s.takeRevision(ctx, work, revision)
+	}
+	if err != nil {
+		s.releaseBlob(ctx, sha256Sum, blob.StoragePath)
+		s.releaseFiles(ctx, members)
+		return nil, nil, err
 	}
 
 	// Создаем модель файла
@@ -87,22 +222,120 @@ func (s *StorageService) UploadFile(ctx context.Context, studentID, assignmentID
 		OriginalFilename: filename,
 		ContentType:      &contentType,
 		SizeBytes:        size,
-		StoragePath:      storagePath,
-		ChecksumMD5:      &md5Hash,
-		ChecksumSHA256:   &sha256Hash,
+		StoragePath:      blob.StoragePath,
+		ChecksumMD5:      &md5Sum,
+		ChecksumSHA256:   &sha256Sum,
 		UploadedAt:       time.Now(),
+		IsArchive:        format != archive.FormatNone,
+		Revision:         revision,
 	}
 
 	// Сохраняем в БД
-	if err := s.fileRepo.CreateFile(ctx, file); err != nil {
-		// Удаляем файл если не удалось сохранить в БД
-		os.Remove(storagePath)
+	if file.IsArchive {
+		for _, member := range members {
+			member.WorkID = work.WorkID
+			member.ParentFileID = &file.FileID
+			member.UploadedAt = file.UploadedAt
+			member.Revision = revision
+		}
+		err = s.fileRepo.CreateArchive(ctx, file, members)
+	} else {
+		err = s.fileRepo.CreateFile(ctx, file)
+	}
+	if err != nil {
+		// Убираем ссылки на содержимое если не удалось сохранить в БД
+		s.releaseBlob(ctx, sha256Sum, blob.StoragePath)
+		s.releaseFiles(ctx, members)
 		return nil, nil, fmt.Errorf("failed to save file metadata to database: %w", err)
 	}
 
+	// Новая ревизия становится текущей; файл уже сохранен, поэтому ошибка
+	// только логируется, а ревизию можно сделать текущей вручную
+	if err := s.workRepo.AdvanceCurrentRevision(ctx, work.WorkID, revision); err != nil {
+		fmt.Printf("Failed to make revision %d of work %s current: %v\n", revision, work.WorkID, err)
+	} else if work.CurrentRevision < revision {
+		work.CurrentRevision = revision
+	}
+
 	return file, work, nil
 }
 
+// acquireBlob добавляет ссылку на содержимое с контрольной суммой checksum.
+// Содержимое из spool записывается в хранилище, только если такого еще нет.
+func (s *StorageService) acquireBlob(ctx context.Context, checksum string, size int64, spool io.ReadSeeker) (*models.Blob, error) {
+	blob, err := s.blobRepo.AddBlobRef(ctx, checksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reference stored content: %w", err)
+	}
+	if blob != nil {
+		return blob, nil
+	}
+
+	// Ключ объекта (используем поддиректории по первым 2 символам SHA-256).
+	// Параллельные загрузки одного содержимого пишут одинаковые байты в один ключ.
+	key := checksum[:2] + "/" + checksum
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind temporary file: %w", err)
+	}
+	if _, err := s.store.Put(ctx, key, spool); err != nil {
+		return nil, fmt.Errorf("failed to save file: %w", err)
+	}
+
+	blob, err = s.blobRepo.SaveBlob(ctx, &models.Blob{
+		ChecksumSHA256: checksum,
+		StoragePath:    s.store.Location(key),
+		SizeBytes:      size,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		// Объект не удаляется: параллельная загрузка того же содержимого могла
+		// записать тот же ключ и уже сослаться на него. Без записи в blobs он
+		// лишь занимает место до следующей загрузки этого содержимого.
+		return nil, fmt.Errorf("failed to save stored content to database: %w", err)
+	}
+	return blob, nil
+}
+
+// releaseBlob убирает ссылку на содержимое и удаляет его из хранилища, когда
+// на него больше не ссылается ни один файл. Возвращает false, если файл не
+// ссылается на общее содержимое (загружен до дедупликации).
+func (s *StorageService) releaseBlob(ctx context.Context, checksum, storagePath string) (bool, error) {
+	blob, err := s.blobRepo.ReleaseBlob(ctx, checksum, storagePath, func(blob *models.Blob) error {
+		key, ok := s.store.Key(blob.StoragePath)
+		if !ok {
+			return fmt.Errorf("content %s is not stored in the %s storage: %s", checksum, s.store.Name(), blob.StoragePath)
+		}
+		if err := s.store.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete file content: %w", err)
+		}
+		return nil
+	})
+	if blob == nil && err != nil {
+		return false, fmt.Errorf("failed to release stored content: %w", err)
+	}
+	return blob != nil, err
+}
+
+// limitedUpload прерывает чтение загружаемого файла ошибкой, если файл
+// пустой или больше limit байт, чтобы хранилище не сохраняло такой объект
+type limitedUpload struct {
+	src   io.Reader
+	limit int64
+	read  int64
+}
+
+func (r *limitedUpload) Read(p []byte) (int, error) {
+	n, err := r.src.Read(p)
+	r.read += int64(n)
+	if r.read > r.limit {
+		return n, fmt.Errorf("%w: more than %d bytes", ErrFileTooLarge, r.limit)
+	}
+	if err == io.EOF && r.read == 0 {
+		return n, ErrEmptyFile
+	}
+	return n, err
+}
+
 // GetFile получает информацию о файле
 func (s *StorageService) GetFile(ctx context.Context, fileID string) (*models.File, error) {
 	return s.fileRepo.GetFileByID(ctx, fileID)
@@ -118,32 +351,39 @@ func (s *StorageService) GetFileMetadata(ctx context.Context, fileID string) (*F
 	if err != nil {
 		return nil, fmt.Errorf("failed to get work for file: %w", err)
 	}
+	metadata := fileMetadata(file)
+	metadata.StudentID = work.StudentID
+	metadata.AssignmentID = work.AssignmentID
+	metadata.IsTemplate = work.IsTemplate
+	metadata.IsCurrentRevision = isCurrentRevision(work, file)
+	return metadata, nil
+}
+
+// fileMetadata метаданные файла без сведений о работе
+func fileMetadata(file *models.File) *FileMetadata {
 	return &FileMetadata{
 		FileID:       file.FileID,
 		WorkID:       file.WorkID,
 		Filename:     file.OriginalFilename,
 		ContentType:  file.ContentType,
 		SizeBytes:    file.SizeBytes,
-		StudentID:    work.StudentID,
-		AssignmentID: work.AssignmentID,
 		UploadedAt:   file.UploadedAt,
 		ChecksumMD5:  file.ChecksumMD5,
-	}, nil
+		IsArchive:    file.IsArchive,
+		ParentFileID: file.ParentFileID,
+		MemberPath:   file.MemberPath,
+		Revision:     file.Revision,
+	}
 }
 
-// GetFileContent получает содержимое файла
-func (s *StorageService) GetFileContent(ctx context.Context, fileID string) ([]byte, error) {
-	file, err := s.fileRepo.GetFileByID(ctx, fileID)
+// OpenFileContent открывает содержимое файла для потокового чтения.
+// Закрыть его должен вызывающий.
+func (s *StorageService) OpenFileContent(ctx context.Context, file *models.File) (io.ReadSeekCloser, error) {
+	key, err := s.storageKey(file)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file metadata: %w", err)
+		return nil, err
 	}
-
-	// Проверяем существование файла
-	if _, err := os.Stat(file.StoragePath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("file not found on disk: %s", file.StoragePath)
-	}
-
-	return os.ReadFile(file.StoragePath)
+	return s.store.Open(ctx, key)
 }
 
 // GetFilesByWorkID получает все файлы работы
@@ -159,29 +399,68 @@ func (s *StorageService) DeleteFile(ctx context.Context, fileID string) error {
 		return fmt.Errorf("file not found: %w", err)
 	}
 
+	// Файлы из архива удаляются из БД вместе с ним, а ссылки на их содержимое
+	// убираются после удаления
+	var members []*models.File
+	if file.IsArchive {
+		members, err = s.fileRepo.GetArchiveMembers(ctx, fileID)
+		if err != nil {
+			return fmt.Errorf("failed to get archive members: %w", err)
+		}
+	}
+
 	// Удаляем из БД
 	if err := s.fileRepo.DeleteFile(ctx, fileID); err != nil {
 		return fmt.Errorf("failed to delete file from database: %w", err)
 	}
+	s.releaseFiles(ctx, members)
 
-	// Удаляем файл с диска
-	if err := os.Remove(file.StoragePath); err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("failed to delete file from disk: %w", err)
+	// Содержимое общее для файлов с одинаковой SHA-256 и удаляется из
+	// хранилища вместе с последней ссылкой на него
+	if file.ChecksumSHA256 != nil {
+		shared, err := s.releaseBlob(ctx, *file.ChecksumSHA256, file.StoragePath)
+		if shared || err != nil {
+			return err
+		}
+	}
+
+	// Удаляем содержимое из хранилища; у файлов, загруженных до дедупликации,
+	// оно собственное
+	key, err := s.storageKey(file)
+	if err != nil {
+		return err
+	}
+	if err := s.store.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete file content: %w", err)
 	}
 
 	return nil
 }
 
+// storageKey ключ содержимого файла в текущем хранилище
+func (s *StorageService) storageKey(file *models.File) (string, error) {
+	key, ok := s.store.Key(file.StoragePath)
+	if !ok {
+		return "", fmt.Errorf("file %s is not stored in the %s storage: %s", file.FileID, s.store.Name(), file.StoragePath)
+	}
+	return key, nil
+}
+
+// BackendName название используемого хранилища
+func (s *StorageService) BackendName() string {
+	return s.store.Name()
+}
+
 func (s *StorageService) CheckFileExists(ctx context.Context, fileID string) ([]string, error) {
 	file, err := s.fileRepo.GetFileByID(ctx, fileID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file: %w", err)
 	}
 
-	if file.ChecksumMD5 == nil {
+	if file.ChecksumSHA256 == nil {
 		return nil, nil
 	}
-	files, err := s.fileRepo.GetFilesByChecksum(ctx, *file.ChecksumMD5, fileID)
+	files, err := s.fileRepo.GetFilesByChecksum(ctx, *file.ChecksumSHA256, fileID)
 	if err != nil {
 		return nil, nil
 	}
@@ -198,13 +477,3 @@ func generateFileID() string {
 		time.Now().UnixNano(),
 		hex.EncodeToString(md5.New().Sum([]byte(fmt.Sprint(time.Now().UnixNano()))))[:8])
 }
-
-func calculateMD5(data []byte) string {
-	hash := md5.Sum(data)
-	return hex.EncodeToString(hash[:])
-}
-
-func calculateSHA256(data []byte) string {
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
-}
@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"sd_hw3/internal/file-storage/models"
+	"sd_hw3/internal/file-storage/sniff"
+)
+
+var (
+	// ErrUploadNotFound загрузки нет или она истекла
+	ErrUploadNotFound = errors.New("upload not found")
+	// ErrUploadOffsetMismatch смещение части не совпадает с числом принятых байт
+	ErrUploadOffsetMismatch = errors.New("upload offset mismatch")
+	// ErrUploadIncomplete приняты не все байты файла
+	ErrUploadIncomplete = errors.New("upload is not complete")
+	// ErrUploadCompleting загрузку уже завершает или отменяет другой запрос
+	ErrUploadCompleting = errors.New("upload is being completed")
+)
+
+// expiredUploadsBatch сколько истекших загрузок удаляется за один проход
+const expiredUploadsBatch = 100
+
+// CreateUpload начинает загрузку частями файла размером size байт
+func (s *StorageService) CreateUpload(ctx context.Context, studentID, assignmentID, filename, contentType string, size int64) (*models.UploadSession, error) {
+	if size <= 0 {
+		return nil, ErrEmptyFile
+	}
+	rules, err := s.rulesFor(ctx, assignmentID, s.config.MaxResumableUploadSize)
+	if err != nil {
+		return nil, err
+	}
+	if size > rules.maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrFileTooLarge, rules.maxSize)
+	}
+	// Заявленный тип проверяется сразу, чтобы не принимать заведомо
+	// недопустимый файл; содержимое проверяется при завершении загрузки
+	if declared := sniff.Normalize(contentType); declared != "" && declared != sniff.OctetStream {
+		if err := s.checkAllowed(rules, filename, declared); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	upload := &models.UploadSession{
+		UploadID:     generateUploadID(),
+		StudentID:    studentID,
+		AssignmentID: assignmentID,
+		Filename:     filename,
+		SizeBytes:    size,
+		Status:       models.UploadOpen,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		ExpiresAt:    now.Add(s.config.UploadSessionTTL),
+	}
+	if contentType != "" {
+		upload.ContentType = &contentType
+	}
+
+	if err := s.uploadRepo.CreateUpload(ctx, upload); err != nil {
+		return nil, fmt.Errorf("failed to create upload: %w", err)
+	}
+	return upload, nil
+}
+
+// GetUpload получает незавершенную загрузку
+func (s *StorageService) GetUpload(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	upload, err := s.uploadRepo.GetUpload(ctx, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload: %w", err)
+	}
+	// Истекшая загрузка недоступна, даже если ее еще не удалила очистка
+	if upload == nil || upload.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrUploadNotFound, uploadID)
+	}
+	return upload, nil
+}
+
+// WriteChunk дописывает в загрузку часть, начинающуюся со смещения offset.
+// Каждая часть хранится отдельным объектом, поэтому загрузку можно продолжить
+// на любой реплике сервиса.
+func (s *StorageService) WriteChunk(ctx context.Context, uploadID string, offset int64, src io.Reader) (*models.UploadSession, error) {
+	upload, err := s.GetUpload(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if s.uploadClaimed(upload) {
+		return nil, fmt.Errorf("%w: %s", ErrUploadCompleting, uploadID)
+	}
+	if offset != upload.Offset {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrUploadOffsetMismatch, upload.Offset, offset)
+	}
+
+	// Ключ уникален для каждого запроса: часть, проигравшая гонку за смещение,
+	// удаляется, не задевая принятую
+	key := fmt.Sprintf("uploads/%s/%020d-%d", uploadID, offset, time.Now().UnixNano())
+	size, err := s.store.Put(ctx, key, &limitedUpload{src: src, limit: upload.SizeBytes - offset})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save chunk: %w", err)
+	}
+
+	updated, err := s.uploadRepo.AddChunk(ctx, &models.UploadChunk{
+		UploadID:    uploadID,
+		Offset:      offset,
+		SizeBytes:   size,
+		StoragePath: s.store.Location(key),
+	}, time.Now().Add(s.config.UploadSessionTTL), s.claimStaleBefore())
+	if err != nil || updated == nil {
+		s.store.Delete(ctx, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save chunk: %w", err)
+	}
+	if updated == nil {
+		return nil, fmt.Errorf("%w: chunk at %d was written concurrently or the upload is being completed", ErrUploadOffsetMismatch, offset)
+	}
+	return updated, nil
+}
+
+// claimStaleBefore загрузки, занятые раньше этого времени, считаются
+// брошенными: занявший их запрос не завершился за UploadClaimTimeout
+func (s *StorageService) claimStaleBefore() time.Time {
+	return time.Now().Add(-s.config.UploadClaimTimeout)
+}
+
+// uploadClaimed загрузку занимает запрос, который еще может ее завершить
+func (s *StorageService) uploadClaimed(upload *models.UploadSession) bool {
+	return upload.Status == models.UploadCompleting && upload.ClaimedAt != nil &&
+		!upload.ClaimedAt.Before(s.claimStaleBefore())
+}
+
+// claimUpload занимает загрузку для завершения или отмены, чтобы
+// параллельный запрос не собрал тот же файл повторно и не удалил части,
+// пока они читаются. Загрузку, занятую дольше UploadClaimTimeout, можно
+// занять снова.
+func (s *StorageService) claimUpload(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	upload, err := s.uploadRepo.ClaimUpload(ctx, uploadID, s.claimStaleBefore(), time.Now().Add(s.config.UploadClaimTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim upload: %w", err)
+	}
+	if upload != nil {
+		return upload, nil
+	}
+
+	// Загрузку не удалось занять: ее нет, она истекла или занята
+	if _, err := s.GetUpload(ctx, uploadID); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUploadCompleting, uploadID)
+}
+
+// reopenUpload возвращает загрузку, которую не удалось завершить, чтобы
+// клиент мог повторить запрос
+func (s *StorageService) reopenUpload(ctx context.Context, upload *models.UploadSession) {
+	if upload.ClaimedAt == nil {
+		return
+	}
+	if err := s.uploadRepo.ReopenUpload(context.WithoutCancel(ctx), upload.UploadID, *upload.ClaimedAt); err != nil {
+		fmt.Printf("Failed to reopen upload %s: %v\n", upload.UploadID, err)
+	}
+}
+
+// CompleteUpload сохраняет принятый файл так же, как UploadFile, и удаляет
+// загрузку. Загрузка сначала занимается, поэтому параллельный запрос на
+// завершение получает ErrUploadCompleting, а не второй файл.
+func (s *StorageService) CompleteUpload(ctx context.Context, uploadID string) (*models.File, *models.Work, error) {
+	upload, err := s.claimUpload(ctx, uploadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if upload.Offset != upload.SizeBytes {
+		s.reopenUpload(ctx, upload)
+		return nil, nil, fmt.Errorf("%w: received %d of %d bytes", ErrUploadIncomplete, upload.Offset, upload.SizeBytes)
+	}
+
+	chunks, err := s.uploadRepo.GetChunks(ctx, uploadID)
+	if err != nil {
+		s.reopenUpload(ctx, upload)
+		return nil, nil, fmt.Errorf("failed to get upload chunks: %w", err)
+	}
+
+	contentType := ""
+	if upload.ContentType != nil {
+		contentType = *upload.ContentType
+	}
+	content := &chunkReader{ctx: ctx, service: s, chunks: chunks}
+	defer content.Close()
+
+	file, work, err := s.uploadFile(ctx, upload.StudentID, upload.AssignmentID, content, s.config.MaxResumableUploadSize, upload.Filename, contentType, 0)
+	if err != nil {
+		s.reopenUpload(ctx, upload)
+		return nil, nil, err
+	}
+
+	if err := s.deleteUpload(ctx, upload.UploadID, chunks); err != nil {
+		fmt.Printf("Failed to delete completed upload %s: %v\n", upload.UploadID, err)
+	}
+	return file, work, nil
+}
+
+// CancelUpload удаляет загрузку и принятые части; завершаемую загрузку
+// отменить нельзя
+func (s *StorageService) CancelUpload(ctx context.Context, uploadID string) error {
+	upload, err := s.claimUpload(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+
+	chunks, err := s.uploadRepo.GetChunks(ctx, upload.UploadID)
+	if err != nil {
+		s.reopenUpload(ctx, upload)
+		return fmt.Errorf("failed to get upload chunks: %w", err)
+	}
+	return s.deleteUpload(ctx, upload.UploadID, chunks)
+}
+
+// StartUploadCleanup периодически удаляет истекшие загрузки и ключи
+// идемпотентности, пока не отменен ctx; interval <= 0 отключает очистку
+func (s *StorageService) StartUploadCleanup(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if deleted, err := s.CleanupExpiredUploads(ctx); err != nil {
+				fmt.Printf("Failed to clean up expired uploads: %v\n", err)
+			} else if deleted > 0 {
+				fmt.Printf("Deleted %d expired uploads\n", deleted)
+			}
+			if deleted, err := s.CleanupExpiredIdempotencyKeys(ctx); err != nil {
+				fmt.Printf("Failed to clean up expired idempotency keys: %v\n", err)
+			} else if deleted > 0 {
+				fmt.Printf("Deleted %d expired idempotency keys\n", deleted)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// CleanupExpiredUploads удаляет истекшие загрузки и возвращает их число
+func (s *StorageService) CleanupExpiredUploads(ctx context.Context) (int, error) {
+	deleted := 0
+	for {
+		uploads, err := s.uploadRepo.GetExpiredUploads(ctx, time.Now(), expiredUploadsBatch)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, upload := range uploads {
+			chunks, err := s.uploadRepo.GetChunks(ctx, upload.UploadID)
+			if err != nil {
+				return deleted, fmt.Errorf("failed to get upload chunks: %w", err)
+			}
+			if err := s.deleteUpload(ctx, upload.UploadID, chunks); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		if len(uploads) < expiredUploadsBatch {
+			return deleted, nil
+		}
+	}
+}
+
+// deleteUpload удаляет загрузку из БД, а затем объекты ее частей
+func (s *StorageService) deleteUpload(ctx context.Context, uploadID string, chunks []*models.UploadChunk) error {
+	if err := s.uploadRepo.DeleteUpload(ctx, uploadID); err != nil {
+		return fmt.Errorf("failed to delete upload: %w", err)
+	}
+
+	for _, chunk := range chunks {
+		key, ok := s.store.Key(chunk.StoragePath)
+		if !ok {
+			continue
+		}
+		if err := s.store.Delete(ctx, key); err != nil {
+			fmt.Printf("Failed to delete chunk %s of upload %s: %v\n", chunk.StoragePath, uploadID, err)
+		}
+	}
+	return nil
+}
+
+// chunkReader читает части загрузки подряд, открывая каждую по мере надобности
+type chunkReader struct {
+	ctx     context.Context
+	service *StorageService
+	chunks  []*models.UploadChunk
+	current io.ReadCloser
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.chunks) == 0 {
+				return 0, io.EOF
+			}
+			chunk := r.chunks[0]
+			r.chunks = r.chunks[1:]
+
+			key, ok := r.service.store.Key(chunk.StoragePath)
+			if !ok {
+				return 0, fmt.Errorf("chunk is not stored in the %s storage: %s", r.service.store.Name(), chunk.StoragePath)
+			}
+			content, err := r.service.store.Open(r.ctx, key)
+			if err != nil {
+				return 0, fmt.Errorf("failed to open chunk: %w", err)
+			}
+			r.current = content
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return err
+}
+
+// generateUploadID случайный идентификатор загрузки: зная его, можно
+// дописывать файл, поэтому он не должен угадываться
+func generateUploadID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return "upload-" + hex.EncodeToString(id)
+}
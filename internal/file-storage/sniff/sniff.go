@@ -0,0 +1,276 @@
+// Package sniff определяет тип содержимого файла по сигнатуре (magic bytes),
+// не доверяя типу, который указал клиент. Для ZIP-контейнеров (DOCX, ODT и
+// т.д.) заглядывает внутрь архива. Текст по сигнатуре не различить, поэтому
+// его вид (Markdown, исходный код) уточняется по заявленному типу или
+// расширению файла.
+package sniff
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// OctetStream тип содержимого, которое не удалось распознать
+const OctetStream = "application/octet-stream"
+
+// sniffLen сколько байт читает http.DetectContentType
+const sniffLen = 512
+
+// signatures сигнатуры форматов, которых не знает http.DetectContentType.
+// Они проверяются, только если содержимое не похоже на текст: короткие
+// сигнатуры вроде MZ встречаются и в начале обычного текста.
+var signatures = []struct {
+	offset int
+	magic  []byte
+	typ    string
+}{
+	{0, []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}, "application/x-ole-storage"},
+	{0, []byte("7z\xbc\xaf\x27\x1c"), "application/x-7z-compressed"},
+	{0, []byte("BZh"), "application/x-bzip2"},
+	{0, []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}, "application/x-xz"},
+	{0, []byte("\x7fELF"), "application/x-executable"},
+	{0, []byte("MZ"), "application/vnd.microsoft.portable-executable"},
+	{257, []byte("ustar"), "application/x-tar"},
+}
+
+// oleTypes документы старого формата Microsoft Office различаются только
+// расширением: все они - контейнеры OLE
+var oleTypes = map[string]string{
+	".doc": "application/msword",
+	".xls": "application/vnd.ms-excel",
+	".ppt": "application/vnd.ms-powerpoint",
+}
+
+// ooxmlTypes документы Office Open XML по каталогу содержимого в архиве
+var ooxmlTypes = map[string]string{
+	"word/": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"xl/":   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"ppt/":  "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+// aliases нестандартные названия типов и их общепринятые варианты
+var aliases = map[string]string{
+	"application/gzip":             "application/x-gzip",
+	"application/x-compressed-tar": "application/x-gzip",
+	"application/x-zip-compressed": "application/zip",
+	"application/x-pdf":            "application/pdf",
+	"application/x-rtf":            "application/rtf",
+	"text/rtf":                     "application/rtf",
+	"application/x-msdownload":     "application/vnd.microsoft.portable-executable",
+	"image/jpg":                    "image/jpeg",
+	"image/pjpeg":                  "image/jpeg",
+	"audio/mp3":                    "audio/mpeg",
+}
+
+// Detect определяет тип содержимого r размером size. declared - тип,
+// указанный клиентом, он используется только для уточнения вида текста.
+func Detect(r io.ReaderAt, size int64, filename, declared string) string {
+	header := make([]byte, sniffLen)
+	n, _ := r.ReadAt(header, 0)
+	header = header[:n]
+
+	detected := Normalize(http.DetectContentType(header))
+	switch {
+	case detected == "text/plain" && bytes.HasPrefix(header, []byte("{\\rtf")):
+		detected = "application/rtf"
+	case detected == OctetStream:
+		for _, sig := range signatures {
+			if len(header) >= sig.offset+len(sig.magic) && bytes.Equal(header[sig.offset:sig.offset+len(sig.magic)], sig.magic) {
+				detected = sig.typ
+				break
+			}
+		}
+	}
+
+	ext := strings.ToLower(path.Ext(filename))
+	switch {
+	case detected == "application/zip":
+		return detectZip(r, size, ext)
+	case detected == "application/x-ole-storage" && oleTypes[ext] != "":
+		return oleTypes[ext]
+	case IsText(detected):
+		return textType(detected, ext, Normalize(declared))
+	}
+	return detected
+}
+
+// detectZip различает документы, хранящиеся в ZIP-контейнере
+func detectZip(r io.ReaderAt, size int64, ext string) string {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "application/zip"
+	}
+
+	for _, f := range zr.File {
+		switch {
+		case f.Name == "mimetype":
+			// В документах OpenDocument тип записан первым файлом архива
+			if t := readMimetype(f); t != "" {
+				return t
+			}
+		case f.Name == "[Content_Types].xml":
+			for _, g := range zr.File {
+				for dir, t := range ooxmlTypes {
+					if strings.HasPrefix(g.Name, dir) {
+						return t
+					}
+				}
+			}
+		case f.Name == "META-INF/MANIFEST.MF" && ext == ".jar":
+			return "application/java-archive"
+		}
+	}
+	return "application/zip"
+}
+
+func readMimetype(f *zip.File) string {
+	if f.UncompressedSize64 > 100 {
+		return ""
+	}
+	content, err := f.Open()
+	if err != nil {
+		return ""
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(io.LimitReader(content, 100))
+	if err != nil {
+		return ""
+	}
+	t := Normalize(string(data))
+	if !strings.HasPrefix(t, "application/vnd.oasis.opendocument.") {
+		return ""
+	}
+	return t
+}
+
+// textType уточняет вид текста: сначала по заявленному типу, затем по расширению
+func textType(detected, ext, declared string) string {
+	if IsText(declared) {
+		return declared
+	}
+	if byExt := Normalize(mime.TypeByExtension(ext)); IsText(byExt) {
+		return byExt
+	}
+	return detected
+}
+
+// Normalize приводит тип к виду type/subtype без параметров и заменяет
+// нестандартные названия общепринятыми
+func Normalize(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(contentType))
+	if err != nil {
+		return ""
+	}
+	if alias, ok := aliases[mediaType]; ok {
+		return alias
+	}
+	return mediaType
+}
+
+// IsText тип текстового содержимого
+func IsText(contentType string) bool {
+	switch {
+	case strings.HasPrefix(contentType, "text/"),
+		strings.HasSuffix(contentType, "+xml"),
+		strings.HasSuffix(contentType, "+json"):
+		return true
+	}
+	switch contentType {
+	case "application/json", "application/xml", "application/javascript",
+		"application/x-sh", "application/sql", "application/yaml",
+		"application/x-yaml", "application/toml", "application/x-python":
+		return true
+	}
+	return false
+}
+
+// zipBased форматы, хранящиеся в ZIP-контейнере
+func zipBased(contentType string) bool {
+	return contentType == "application/zip" || contentType == "application/java-archive" ||
+		strings.HasPrefix(contentType, "application/vnd.openxmlformats-officedocument.") ||
+		strings.HasPrefix(contentType, "application/vnd.oasis.opendocument.")
+}
+
+// signed типы, которые распознаются по сигнатуре: если содержимое не
+// распознано, файл такого типа заявлен ложно
+var signed = map[string]bool{
+	"application/pdf":               true,
+	"application/rtf":               true,
+	"application/postscript":        true,
+	"application/x-gzip":            true,
+	"application/x-tar":             true,
+	"application/x-rar-compressed":  true,
+	"application/x-7z-compressed":   true,
+	"application/x-bzip2":           true,
+	"application/x-xz":              true,
+	"application/msword":            true,
+	"application/vnd.ms-excel":      true,
+	"application/vnd.ms-powerpoint": true,
+	"application/wasm":              true,
+}
+
+// Compatible заявленный тип claimed не противоречит определенному по
+// содержимому. Пустой тип и application/octet-stream ничего не утверждают;
+// разные виды текста и ZIP-контейнеров по сигнатуре не различить. Если
+// содержимое не распознано, противоречием считается только заявленный тип,
+// который распознавался бы (PDF, изображения, текст и т.д.).
+func Compatible(claimed, detected string) bool {
+	claimed = Normalize(claimed)
+	switch {
+	case claimed == "", claimed == OctetStream, claimed == detected:
+		return true
+	case IsText(claimed) && IsText(detected):
+		return true
+	case zipBased(claimed) && zipBased(detected):
+		return true
+	case claimed == "application/x-tar" && detected == "application/x-gzip":
+		// .tar.gz часто отправляют как application/x-tar
+		return true
+	case detected == OctetStream:
+		return !signed[claimed] && !IsText(claimed) && !zipBased(claimed) &&
+			!strings.HasPrefix(claimed, "image/")
+	}
+	return false
+}
+
+// ExtensionType тип, соответствующий расширению файла; пустой, если неизвестен
+func ExtensionType(filename string) string {
+	return Normalize(mime.TypeByExtension(strings.ToLower(path.Ext(filename))))
+}
+
+// Allowed тип разрешен списком allowed из типов вида type/subtype или type/*.
+// Пустой список разрешает любой тип.
+func Allowed(allowed []string, contentType string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(contentType, prefix+"/") {
+				return true
+			}
+			continue
+		}
+		if Normalize(pattern) == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidPattern строка подходит для списка допустимых типов
+func ValidPattern(pattern string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return prefix != "" && !strings.ContainsAny(prefix, "/*; ")
+	}
+	mediaType, params, err := mime.ParseMediaType(pattern)
+	return err == nil && len(params) == 0 && strings.Count(mediaType, "/") == 1 && !strings.Contains(mediaType, "*")
+}
@@ -0,0 +1,232 @@
+// Package textdiff сравнивает тексты построчно алгоритмом Майерса и выводит
+// разницу в формате unified diff. Число правок ограничивается, чтобы сравнение
+// двух совершенно разных больших файлов не занимало много памяти и времени.
+package textdiff
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTooManyChanges тексты различаются больше, чем на допустимое число правок
+var ErrTooManyChanges = errors.New("too many changes")
+
+// Kind вид правки
+type Kind int
+
+const (
+	Equal Kind = iota
+	Delete
+	Insert
+)
+
+// Edit строка, оставшаяся без изменений, удаленная или добавленная
+type Edit struct {
+	Kind Kind
+	// Line строка вместе с завершающим переводом строки, если он есть
+	Line string
+}
+
+// Lines разбивает текст на строки, сохраняя переводы строк
+func Lines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Diff находит кратчайший список правок, превращающий from в to. Если правок
+// больше maxEdits, возвращается ErrTooManyChanges.
+func Diff(from, to []string, maxEdits int) ([]Edit, error) {
+	// Общие начало и конец не участвуют в поиске, это сильно сокращает работу
+	// для почти одинаковых файлов
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix &&
+		from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, len(from)+len(to)-prefix-suffix)
+	for _, line := range from[:prefix] {
+		edits = append(edits, Edit{Kind: Equal, Line: line})
+	}
+	middle, err := myers(from[prefix:len(from)-suffix], to[prefix:len(to)-suffix], maxEdits)
+	if err != nil {
+		return nil, err
+	}
+	edits = append(edits, middle...)
+	for _, line := range from[len(from)-suffix:] {
+		edits = append(edits, Edit{Kind: Equal, Line: line})
+	}
+	return edits, nil
+}
+
+// myers жадный алгоритм Майерса O((N+M)D). Для обратного прохода на каждом
+// шаге d сохраняется только используемая часть массива V (2d+3 значения).
+func myers(a, b []string, maxEdits int) ([]Edit, error) {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		if d > maxEdits {
+			return nil, fmt.Errorf("%w: more than %d", ErrTooManyChanges, maxEdits)
+		}
+		snapshot := make([]int, 2*d+3)
+		copy(snapshot, v[offset-d-1:offset+d+2])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(a, b, trace), nil
+			}
+		}
+	}
+	return backtrack(a, b, trace), nil
+}
+
+// backtrack восстанавливает правки по сохраненным состояниям V
+func backtrack(a, b []string, trace [][]int) []Edit {
+	var edits []Edit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			edits = append(edits, Edit{Kind: Equal, Line: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, Edit{Kind: Insert, Line: b[y-1]})
+			} else {
+				edits = append(edits, Edit{Kind: Delete, Line: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// Stats число добавленных и удаленных строк
+func Stats(edits []Edit) (added, removed int) {
+	for _, edit := range edits {
+		switch edit.Kind {
+		case Insert:
+			added++
+		case Delete:
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// Unified выводит правки в формате unified diff с context строками контекста
+// вокруг каждого изменения. Для одинаковых текстов возвращается пустая строка.
+func Unified(fromName, toName string, edits []Edit, context int) string {
+	// Номера строк в обоих текстах перед каждой правкой
+	fromPos := make([]int, len(edits)+1)
+	toPos := make([]int, len(edits)+1)
+	for i, edit := range edits {
+		fromPos[i+1], toPos[i+1] = fromPos[i], toPos[i]
+		if edit.Kind != Insert {
+			fromPos[i+1]++
+		}
+		if edit.Kind != Delete {
+			toPos[i+1]++
+		}
+	}
+
+	var out strings.Builder
+	for i := 0; i < len(edits); {
+		if edits[i].Kind == Equal {
+			i++
+			continue
+		}
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+		}
+
+		// Изменения, между которыми не больше 2*context общих строк, попадают
+		// в один фрагмент
+		start := max(i-context, 0)
+		end := i
+		for {
+			for end < len(edits) && edits[end].Kind != Equal {
+				end++
+			}
+			next := end
+			for next < len(edits) && edits[next].Kind == Equal && next-end <= 2*context {
+				next++
+			}
+			if next < len(edits) && edits[next].Kind != Equal {
+				end = next
+				continue
+			}
+			break
+		}
+		stop := min(end+context, len(edits))
+
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n",
+			hunkRange(fromPos[start], fromPos[stop]-fromPos[start]),
+			hunkRange(toPos[start], toPos[stop]-toPos[start]))
+		for _, edit := range edits[start:stop] {
+			out.WriteString(prefixes[edit.Kind])
+			out.WriteString(edit.Line)
+			if !strings.HasSuffix(edit.Line, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = stop
+	}
+	return out.String()
+}
+
+var prefixes = map[Kind]string{Equal: " ", Delete: "-", Insert: "+"}
+
+// hunkRange диапазон строк фрагмента: номер первой строки с единицы и их число
+func hunkRange(pos, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", pos)
+	case 1:
+		return fmt.Sprintf("%d", pos+1)
+	}
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
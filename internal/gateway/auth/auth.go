@@ -0,0 +1,156 @@
+// Package auth проверяет JWT-токены клиентов gateway (HS256) и решает, к
+// работам каких студентов и заданий у пользователя есть доступ.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken токен поврежден, подписан другим ключом или другим алгоритмом
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenExpired срок действия токена истек или еще не начался
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Role роль пользователя
+type Role string
+
+const (
+	// RoleStudent студент: отправляет свои работы и видит только их
+	RoleStudent Role = "student"
+	// RoleTeacher преподаватель: видит все работы по своим заданиям
+	RoleTeacher Role = "teacher"
+	// RoleAdmin администратор: доступ ко всему
+	RoleAdmin Role = "admin"
+)
+
+// leeway допустимое расхождение часов с сервером, выпустившим токен
+const leeway = 30 * time.Second
+
+// Claims утверждения токена
+type Claims struct {
+	// Subject идентификатор пользователя; для студента - student_id
+	Subject string `json:"sub"`
+	Role    Role   `json:"role"`
+	// Assignments задания, которые ведет преподаватель
+	Assignments []string `json:"assignments,omitempty"`
+	ExpiresAt   int64    `json:"exp"`
+	NotBefore   int64    `json:"nbf,omitempty"`
+	IssuedAt    int64    `json:"iat,omitempty"`
+}
+
+// Verifier проверяет подпись и срок действия токенов
+type Verifier struct {
+	key []byte
+	now func() time.Time
+}
+
+// NewVerifier создает Verifier с ключом подписи key
+func NewVerifier(key []byte) *Verifier {
+	return &Verifier{key: key, now: time.Now}
+}
+
+// Verify разбирает токен вида header.payload.signature и проверяет его.
+// Принимается только HS256, поле exp обязательно.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	// Алгоритм задает сервер, а не токен: иначе подошел бы и "none"
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad signature encoding", ErrInvalidToken)
+	}
+	if !hmac.Equal(signature, v.sign(parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidToken)
+	}
+	switch claims.Role {
+	case RoleStudent, RoleTeacher, RoleAdmin:
+	default:
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, claims.Role)
+	}
+
+	if claims.ExpiresAt == 0 {
+		return nil, fmt.Errorf("%w: no expiration time", ErrInvalidToken)
+	}
+	now := v.now()
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(leeway)) {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotBefore != 0 && now.Add(leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, ErrTokenExpired
+	}
+	return &claims, nil
+}
+
+func (v *Verifier) sign(data string) []byte {
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("%w: bad encoding", ErrInvalidToken)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: bad json", ErrInvalidToken)
+	}
+	return nil
+}
+
+// CanAccess пользователь может работать с работой студента studentID по
+// заданию assignmentID: студент - только со своими, преподаватель - со всеми
+// по своим заданиям, администратор - со всеми
+func (c *Claims) CanAccess(studentID, assignmentID string) bool {
+	switch c.Role {
+	case RoleAdmin:
+		return true
+	case RoleTeacher:
+		return c.OwnsAssignment(assignmentID)
+	case RoleStudent:
+		return studentID != "" && studentID == c.Subject
+	}
+	return false
+}
+
+// OwnsAssignment преподаватель ведет задание (администратор - любое)
+func (c *Claims) OwnsAssignment(assignmentID string) bool {
+	switch c.Role {
+	case RoleAdmin:
+		return true
+	case RoleTeacher:
+		return assignmentID != "" && slices.Contains(c.Assignments, assignmentID)
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	gateway "sd_hw3/api/generated/gateway"
+	"sd_hw3/internal/gateway/auth"
+	"sd_hw3/pkg/apiclient"
+
+	"github.com/labstack/echo/v4"
+)
+
+// claimsKey ключ утверждений токена в контексте запроса
+const claimsKey = "auth.claims"
+
+// errForbidden у пользователя нет доступа к объекту
+var errForbidden = errors.New("forbidden")
+
+// Authenticate проверяет Bearer-токен в заголовке Authorization и сохраняет
+// его утверждения в контексте запроса. Маршруты public доступны без токена.
+func Authenticate(verifier *auth.Verifier, public ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			for _, path := range public {
+				if ctx.Path() == path {
+					return next(ctx)
+				}
+			}
+
+			token, ok := strings.CutPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || strings.TrimSpace(token) == "" {
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return unauthorized(ctx, "Bearer token is required")
+			}
+			claims, err := verifier.Verify(strings.TrimSpace(token))
+			if err != nil {
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				if errors.Is(err, auth.ErrTokenExpired) {
+					return unauthorized(ctx, "Token expired")
+				}
+				return unauthorized(ctx, "Invalid token")
+			}
+
+			ctx.Set(claimsKey, claims)
+			return next(ctx)
+		}
+	}
+}
+
+// claimsFrom утверждения токена текущего запроса
+func claimsFrom(ctx echo.Context) *auth.Claims {
+	claims, _ := ctx.Get(claimsKey).(*auth.Claims)
+	if claims == nil {
+		// Без Authenticate доступа нет ни к чему
+		return &auth.Claims{}
+	}
+	return claims
+}
+
+// checkWork проверяет доступ пользователя к работе
+func (h *Handler) checkWork(ctx echo.Context, workID string) error {
+	work, err := h.fileStorageService.GetWork(ctx.Request().Context(), workID)
+	if err != nil {
+		return err
+	}
+	if !claimsFrom(ctx).CanAccess(work.StudentID, work.AssignmentID) {
+		return errForbidden
+	}
+	return nil
+}
+
+// checkUpload проверяет доступ пользователя к загрузке частями
+func (h *Handler) checkUpload(ctx echo.Context, uploadID string) error {
+	upload, err := h.fileStorageService.GetUpload(ctx.Request().Context(), uploadID)
+	if err != nil {
+		return err
+	}
+	if !claimsFrom(ctx).CanAccess(upload.StudentID, upload.AssignmentID) {
+		return errForbidden
+	}
+	return nil
+}
+
+// accessDenied отвечает на ошибку checkWork и checkUpload
+func accessDenied(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, errForbidden):
+		return forbidden(ctx, "Access denied")
+	case errors.Is(err, apiclient.ErrNotFound):
+		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("NOT_FOUND")),
+			Message:   stringPtr("Not found"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	return storageUnavailable(ctx)
+}
+
+func unauthorized(ctx echo.Context, message string) error {
+	return ctx.JSON(http.StatusUnauthorized, gateway.ApiError{
+		Error:     (*gateway.ApiErrorError)(stringPtr("UNAUTHORIZED")),
+		Message:   stringPtr(message),
+		Timestamp: &[]time.Time{time.Now()}[0],
+	})
+}
+
+func forbidden(ctx echo.Context, message string) error {
+	return ctx.JSON(http.StatusForbidden, gateway.ApiError{
+		Error:     (*gateway.ApiErrorError)(stringPtr("FORBIDDEN")),
+		Message:   stringPtr(message),
+		Timestamp: &[]time.Time{time.Now()}[0],
+	})
+}
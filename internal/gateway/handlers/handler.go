@@ -1,15 +1,19 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
-	"strconv"
 	"time"
 
 	gateway "sd_hw3/api/generated/gateway"
 	"sd_hw3/internal/gateway/models"
 	"sd_hw3/internal/gateway/service"
+	"sd_hw3/pkg/apiclient"
 
 	"github.com/labstack/echo/v4"
 )
@@ -26,7 +30,18 @@ func NewHandler(fileStorageService service.FileStorageService, fileAnalysisServi
 	}
 }
 
-func (h *Handler) SubmitWork(ctx echo.Context) error {
+// SubmitWork загружает файлы работы (часть file может повторяться) и ставит
+// их в очередь анализа одним отчетом. С заголовком Idempotency-Key повтор
+// запроса получает первоначальный ответ, файлы не загружаются снова.
+func (h *Handler) SubmitWork(ctx echo.Context, params gateway.SubmitWorkParams) error {
+	if params.IdempotencyKey != nil && !validIdempotencyKey(*params.IdempotencyKey) {
+		return ctx.JSON(http.StatusBadRequest, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("VALIDATION_ERROR")),
+			Message:   stringPtr(fmt.Sprintf("Idempotency-Key must be 1 to %d printable ASCII characters", maxIdempotencyKeyLength)),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+
 	// Парсим multipart форму
 	form, err := ctx.MultipartForm()
 	if err != nil {
@@ -48,8 +63,11 @@ func (h *Handler) SubmitWork(ctx echo.Context) error {
 			Timestamp: &[]time.Time{time.Now()}[0],
 		})
 	}
+	if !claimsFrom(ctx).CanAccess(studentID, assignmentID) {
+		return forbidden(ctx, "Cannot submit a work on behalf of another student")
+	}
 
-	// Получаем файл
+	// Получаем файлы
 	files := form.File["file"]
 	if len(files) == 0 {
 		return ctx.JSON(http.StatusBadRequest, gateway.ApiError{
@@ -59,33 +77,93 @@ func (h *Handler) SubmitWork(ctx echo.Context) error {
 		})
 	}
 
-	fileHeader := files[0]
+	if params.IdempotencyKey != nil {
+		return h.submitIdempotent(ctx, *params.IdempotencyKey, studentID, assignmentID, files)
+	}
+	return h.submitWork(ctx, studentID, assignmentID, files)
+}
 
-	// 1. Загружаем файл в хранилище
-	uploadResp, err := h.fileStorageService.UploadFile(ctx.Request().Context(), studentID, assignmentID, fileHeader)
-	if err != nil {
-		return ctx.JSON(http.StatusServiceUnavailable, gateway.ApiError{
-			Error:     (*gateway.ApiErrorError)(stringPtr("SERVICE_UNAVAILABLE")),
-			Message:   stringPtr("File storage service unavailable"),
-			Timestamp: &[]time.Time{time.Now()}[0],
+// submitWork загружает проверенные файлы работы и ставит их в очередь анализа
+func (h *Handler) submitWork(ctx echo.Context, studentID, assignmentID string, files []*multipart.FileHeader) error {
+	// 1. Загружаем файлы в хранилище; все они попадают в одну работу студента.
+	// Первый файл создает новую ревизию работы, остальные добавляются в нее же.
+	// Если какой-то файл не загрузился, ревизия с уже загруженными удаляется.
+	uploads := make([]*models.WorkSubmissionResponse, 0, len(files))
+	revision := 0
+	for _, fileHeader := range files {
+		uploadResp, err := h.fileStorageService.UploadFile(ctx.Request().Context(), studentID, assignmentID, revision, fileHeader)
+		if err != nil && len(uploads) > 0 {
+			h.discardRevision(ctx, uploads[0].WorkID, revision)
+		}
+		if rejected, ok := service.IsUploadRejected(err); ok {
+			// Отказ в приеме файла - ошибка клиента, передаем ее как есть
+			return ctx.JSON(rejected.StatusCode, gateway.ApiError{
+				Error:     (*gateway.ApiErrorError)(stringPtr(rejected.Code)),
+				Message:   stringPtr(fmt.Sprintf("%s: %s", fileHeader.Filename, rejected.Message)),
+				Timestamp: &[]time.Time{time.Now()}[0],
+			})
+		}
+		if err != nil {
+			return ctx.JSON(http.StatusServiceUnavailable, gateway.ApiError{
+				Error:     (*gateway.ApiErrorError)(stringPtr("SERVICE_UNAVAILABLE")),
+				Message:   stringPtr(fmt.Sprintf("File storage service unavailable: failed to store %s", fileHeader.Filename)),
+				Timestamp: &[]time.Time{time.Now()}[0],
+			})
+		}
+		uploads = append(uploads, uploadResp)
+		revision = uploadResp.Revision
+	}
+
+	// 2. Запускаем анализ файлов и возвращаем ответ
+	return h.queueAnalysis(ctx, uploads, &studentID, &assignmentID)
+}
+
+// discardRevision удаляет ревизию отправки, загруженной не целиком. Ошибка
+// только логируется: клиент и так получит ошибку загрузки.
+func (h *Handler) discardRevision(ctx echo.Context, workID string, revision int) {
+	// Удаление не должно прерываться, если клиент уже отключился
+	deleteCtx := context.WithoutCancel(ctx.Request().Context())
+	if err := h.fileStorageService.DeleteWorkRevision(deleteCtx, workID, revision); err != nil {
+		fmt.Printf("Failed to delete incomplete revision %d of work %s: %v\n", revision, workID, err)
+	}
+}
+
+// queueAnalysis ставит загруженные файлы работы в очередь анализа одним
+// отчетом и отвечает клиенту
+func (h *Handler) queueAnalysis(ctx echo.Context, uploads []*models.WorkSubmissionResponse, studentID, assignmentID *string) error {
+	uploadResp := uploads[0]
+	submitted := make([]gateway.SubmittedFile, 0, len(uploads))
+	var fileIDs []string
+	for _, upload := range uploads {
+		submitted = append(submitted, gateway.SubmittedFile{
+			FileId:    upload.FileID,
+			Filename:  stringPtr(upload.Filename),
+			SizeBytes: &upload.SizeBytes,
 		})
+		fileIDs = append(fileIDs, upload.FileID)
+	}
+	if len(fileIDs) == 1 {
+		fileIDs = nil
 	}
 
-	// 2. Запускаем анализ файла
 	analysisReq := &models.AnalysisRequest{
 		WorkID:       uploadResp.WorkID,
 		FileID:       uploadResp.FileID,
-		StudentID:    &studentID,
-		AssignmentID: &assignmentID,
+		FileIDs:      fileIDs,
+		StudentID:    studentID,
+		AssignmentID: assignmentID,
 	}
 
-	_, err = h.fileAnalysisService.AnalyzeFile(ctx.Request().Context(), analysisReq)
+	// Анализ выполняется асинхронно: сервис анализа только ставит файл в очередь
+	report, err := h.fileAnalysisService.AnalyzeFile(ctx.Request().Context(), analysisReq)
 	if err != nil {
-		// Анализ может завершиться ошибкой, но файл уже загружен
+		// Анализ может не встать в очередь, но файл уже загружен
 		// Возвращаем успешный ответ с информацией о загрузке
 		return ctx.JSON(http.StatusOK, gateway.WorkSubmissionResponse{
 			WorkId:      stringPtr(uploadResp.WorkID),
 			FileId:      stringPtr(uploadResp.FileID),
+			Files:       &submitted,
+			Revision:    revisionPtr(uploadResp.Revision),
 			SubmittedAt: &uploadResp.UploadedAt,
 		})
 	}
@@ -94,13 +172,20 @@ func (h *Handler) SubmitWork(ctx echo.Context) error {
 	response := gateway.WorkSubmissionResponse{
 		WorkId:      stringPtr(uploadResp.WorkID),
 		FileId:      stringPtr(uploadResp.FileID),
+		Files:       &submitted,
+		Revision:    revisionPtr(uploadResp.Revision),
 		SubmittedAt: &uploadResp.UploadedAt,
+		ReportId:    stringPtr(report.ReportID),
 	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
 
 func (h *Handler) GetWorkReports(ctx echo.Context, workId string) error {
+	if err := h.checkWork(ctx, workId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
 	reports, err := h.fileAnalysisService.GetWorkReports(ctx.Request().Context(), workId)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
@@ -113,9 +198,48 @@ func (h *Handler) GetWorkReports(ctx echo.Context, workId string) error {
 	return ctx.JSON(http.StatusOK, reports)
 }
 
+// GetReportDiff показывает совпадения с чужой работой, поэтому доступен только
+// преподавателю задания и администратору
+func (h *Handler) GetReportDiff(ctx echo.Context, reportId string, similarId string) error {
+	report, err := h.fileAnalysisService.GetReport(ctx.Request().Context(), reportId)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("NOT_FOUND")),
+			Message:   stringPtr("Report not found"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	work, err := h.fileStorageService.GetWork(ctx.Request().Context(), report.WorkID)
+	if err != nil {
+		return accessDenied(ctx, err)
+	}
+	if !claimsFrom(ctx).OwnsAssignment(work.AssignmentID) {
+		return forbidden(ctx, "Comparisons are available to teachers of the assignment only")
+	}
+
+	page, err := h.fileAnalysisService.GetReportDiff(ctx.Request().Context(), reportId, similarId)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("NOT_FOUND")),
+			Message:   stringPtr("Comparison not found for this report"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+
+	return ctx.HTMLBlob(http.StatusOK, page)
+}
+
+// proxiedDownloadHeaders заголовки ответа file-storage, передаваемые клиенту при скачивании
+var proxiedDownloadHeaders = []string{
+	"Content-Type", "Content-Length", "Content-Range", "Accept-Ranges", "ETag", "Last-Modified",
+}
+
 func (h *Handler) DownloadFile(ctx echo.Context, fileId string) error {
 	// Получаем метаданные файла
 	metadata, err := h.fileStorageService.GetFileMetadata(ctx.Request().Context(), fileId)
+	if err != nil && !errors.Is(err, apiclient.ErrNotFound) {
+		return storageUnavailable(ctx)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
 			Error:     (*gateway.ApiErrorError)(stringPtr("NOT_FOUND")),
@@ -123,9 +247,13 @@ func (h *Handler) DownloadFile(ctx echo.Context, fileId string) error {
 			Timestamp: &[]time.Time{time.Now()}[0],
 		})
 	}
+	// Файлы шаблона задания выдаются всем, это исходные материалы для студентов
+	if !metadata.IsTemplate && !claimsFrom(ctx).CanAccess(metadata.StudentID, metadata.AssignmentID) {
+		return forbidden(ctx, "Access denied")
+	}
 
-	// Скачиваем файл
-	content, contentType, contentLength, err := h.fileStorageService.DownloadFile(ctx.Request().Context(), fileId)
+	// Скачиваем файл, передавая file-storage заголовки Range и условных запросов
+	download, err := h.fileStorageService.DownloadFile(ctx.Request().Context(), fileId, ctx.Request().Header)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, gateway.ApiError{
 			Error:     (*gateway.ApiErrorError)(stringPtr("INTERNAL_ERROR")),
@@ -133,22 +261,22 @@ func (h *Handler) DownloadFile(ctx echo.Context, fileId string) error {
 			Timestamp: &[]time.Time{time.Now()}[0],
 		})
 	}
-	defer content.Close()
+	defer download.Body.Close()
 
 	// Устанавливаем заголовки
-	ctx.Response().Header().Set("Content-Type", contentType)
-	ctx.Response().Header().Set("Content-Length", strconv.FormatInt(contentLength, 10))
+	for _, name := range proxiedDownloadHeaders {
+		if value := download.Header.Get(name); value != "" {
+			ctx.Response().Header().Set(name, value)
+		}
+	}
 	ctx.Response().Header().Set("Content-Disposition",
-		"attachment; filename=\""+metadata.Filename+"\"")
+		mime.FormatMediaType("attachment", map[string]string{"filename": metadata.Filename}))
+	ctx.Response().WriteHeader(download.StatusCode)
 
-	// Отправляем файл
-	_, err = io.Copy(ctx.Response().Writer, content)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, gateway.ApiError{
-			Error:     (*gateway.ApiErrorError)(stringPtr("INTERNAL_ERROR")),
-			Message:   stringPtr("Failed to send file"),
-			Timestamp: &[]time.Time{time.Now()}[0],
-		})
+	// Отправляем файл потоком
+	if _, err := io.Copy(ctx.Response(), download.Body); err != nil {
+		// Заголовки уже отправлены, сообщить клиенту об ошибке нельзя
+		fmt.Printf("Failed to send file %s: %v\n", fileId, err)
 	}
 
 	return nil
@@ -161,12 +289,12 @@ func (h *Handler) HealthCheck(ctx echo.Context) error {
 	fileAnalysisStatus := "unhealthy"
 
 	// Проверка file-storage
-	if _, err := h.fileStorageService.GetFileMetadata(ctx.Request().Context(), "health-check"); err == nil {
+	if err := h.fileStorageService.Health(ctx.Request().Context()); err == nil {
 		fileStorageStatus = "healthy"
 	}
 
 	// Проверка file-analysis
-	if _, err := h.fileAnalysisService.ListReports(ctx.Request().Context(), &models.ListReportsParams{Limit: intPtr(1)}); err == nil {
+	if err := h.fileAnalysisService.Health(ctx.Request().Context()); err == nil {
 		fileAnalysisStatus = "healthy"
 	}
 
@@ -175,6 +303,9 @@ func (h *Handler) HealthCheck(ctx echo.Context) error {
 		"gateway":       gatewayStatus,
 		"file_storage":  fileStorageStatus,
 		"file_analysis": fileAnalysisStatus,
+		// Пока выключатель разомкнут, запросы к сервису сразу получают 503
+		"file_storage_circuit":  h.fileStorageService.BreakerState().String(),
+		"file_analysis_circuit": h.fileAnalysisService.BreakerState().String(),
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -185,8 +316,12 @@ func stringPtr(s string) *string {
 	return &s
 }
 
-func intPtr(i int) *int {
-	return &i
+// revisionPtr nil для ответов file-storage без номера ревизии
+func revisionPtr(revision int) *int {
+	if revision == 0 {
+		return nil
+	}
+	return &revision
 }
 
 func getFormValue(form *multipart.Form, key string) string {
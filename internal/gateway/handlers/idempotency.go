@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	gateway "sd_hw3/api/generated/gateway"
+	"sd_hw3/internal/gateway/models"
+	"sd_hw3/pkg/apiclient"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// idempotentReplayedHeader отмечает ответ, сохраненный при первом запросе
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength наибольшая длина заголовка Idempotency-Key
+	maxIdempotencyKeyLength = 255
+)
+
+// validIdempotencyKey ключ из 1-255 печатных символов ASCII
+func validIdempotencyKey(key string) bool {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// submitIdempotent выполняет отправку работы не больше одного раза для ключа
+// key пользователя. Ключ хранится в file-storage вместе с отпечатком запроса
+// и ответом; повтор с тем же ключом и теми же файлами получает сохраненный
+// ответ. Если отправка не удалась или работа не поставлена в очередь
+// анализа, ключ освобождается для повтора.
+func (h *Handler) submitIdempotent(ctx echo.Context, key, studentID, assignmentID string, files []*multipart.FileHeader) error {
+	requestHash, err := submissionHash(studentID, assignmentID, files)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("VALIDATION_ERROR")),
+			Message:   stringPtr(fmt.Sprintf("Failed to read uploaded files: %v", err)),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	// Ключи разных пользователей не пересекаются
+	keyID := idempotencyKeyID(claimsFrom(ctx).Subject, key)
+
+	replay, err := h.fileStorageService.ReserveIdempotencyKey(ctx.Request().Context(), keyID, requestHash)
+	var apiErr *apiclient.Error
+	if errors.As(err, &apiErr) && apiErr.Rejected() {
+		// Запрос с ключом еще выполняется (409) или ключ использован с другой
+		// работой (422)
+		return ctx.JSON(apiErr.StatusCode, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr(apiErr.Code)),
+			Message:   stringPtr(fmt.Sprintf("Idempotency-Key: %s", apiErr.Message)),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+	if replay != nil {
+		ctx.Response().Header().Set(idempotentReplayedHeader, "true")
+		return ctx.JSONBlob(replay.StatusCode, replay.Body)
+	}
+
+	// Ответ записывается клиенту и одновременно сохраняется для повторов
+	recorder := &responseRecorder{ResponseWriter: ctx.Response().Writer}
+	ctx.Response().Writer = recorder
+	err = h.submitWork(ctx, studentID, assignmentID, files)
+	ctx.Response().Writer = recorder.ResponseWriter
+
+	// Сохраняется только ответ отправки, поставленной в очередь анализа:
+	// работу без отчета клиент должен иметь возможность отправить снова
+	response := &models.IdempotentResponse{StatusCode: ctx.Response().Status, Body: recorder.body.Bytes()}
+	var submitted gateway.WorkSubmissionResponse
+	completed := err == nil && response.StatusCode >= 200 && response.StatusCode < 300 &&
+		json.Unmarshal(response.Body, &submitted) == nil && submitted.ReportId != nil
+
+	// Клиент мог не дождаться ответа, а ключ должен быть сохранен или
+	// освобожден и в этом случае
+	saveCtx := context.WithoutCancel(ctx.Request().Context())
+	if !completed {
+		if releaseErr := h.fileStorageService.ReleaseIdempotencyKey(saveCtx, keyID); releaseErr != nil && !errors.Is(releaseErr, apiclient.ErrNotFound) {
+			fmt.Printf("Failed to release idempotency key %s: %v\n", keyID, releaseErr)
+		}
+		return err
+	}
+
+	response.WorkID = apiclient.Value(submitted.WorkId)
+	response.FileID = apiclient.Value(submitted.FileId)
+	response.ReportID = *submitted.ReportId
+	if err := h.fileStorageService.CompleteIdempotencyKey(saveCtx, keyID, requestHash, response); err != nil {
+		// Ключ останется занятым, пока не истечет его блокировка
+		fmt.Printf("Failed to save response for idempotency key %s: %v\n", keyID, err)
+	}
+	return nil
+}
+
+// idempotencyKeyID идентификатор ключа key пользователя subject в file-storage
+func idempotencyKeyID(subject, key string) string {
+	sum := sha256.Sum256([]byte(subject + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// submissionHash отпечаток отправки работы: студент, задание, имена, размеры и
+// содержимое файлов в порядке отправки
+func submissionHash(studentID, assignmentID string, files []*multipart.FileHeader) (string, error) {
+	hash := sha256.New()
+	io.WriteString(hash, studentID+"\x00"+assignmentID+"\x00")
+	for _, fileHeader := range files {
+		io.WriteString(hash, fileHeader.Filename+"\x00"+strconv.FormatInt(fileHeader.Size, 10)+"\x00")
+
+		src, err := fileHeader.Open()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", fileHeader.Filename, err)
+		}
+		_, err = io.Copy(hash, src)
+		src.Close()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", fileHeader.Filename, err)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// responseRecorder копирует тело ответа, записываемое клиенту
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	gateway "sd_hw3/api/generated/gateway"
+	"sd_hw3/pkg/apiclient"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListWorkRevisions список ревизий работы
+func (h *Handler) ListWorkRevisions(ctx echo.Context, workId string) error {
+	if err := h.checkWork(ctx, workId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.ListWorkRevisions(ctx.Request().Context(), workId)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// SetCurrentRevision делает ревизию работы текущей
+func (h *Handler) SetCurrentRevision(ctx echo.Context, workId string) error {
+	if err := h.checkWork(ctx, workId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.SetCurrentRevision(ctx.Request().Context(), workId, ctx.Request().Body)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// DiffWorkRevisions сравнивает две ревизии работы
+func (h *Handler) DiffWorkRevisions(ctx echo.Context, workId string, params gateway.DiffWorkRevisionsParams) error {
+	if err := h.checkWork(ctx, workId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.DiffWorkRevisions(ctx.Request().Context(), workId, params.From, params.To)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// DownloadWorkRevision скачивает ревизию работы потоком
+func (h *Handler) DownloadWorkRevision(ctx echo.Context, workId string, revision int) error {
+	if err := h.checkWork(ctx, workId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	download, err := h.fileStorageService.DownloadWorkRevision(ctx.Request().Context(), workId, revision, ctx.Request().Header)
+	if err != nil && !errors.Is(err, apiclient.ErrNotFound) {
+		return storageUnavailable(ctx)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("NOT_FOUND")),
+			Message:   stringPtr("Revision not found"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	defer download.Body.Close()
+
+	// Имя файла или архива задает file-storage
+	for _, name := range append(proxiedDownloadHeaders, echo.HeaderContentDisposition) {
+		if value := download.Header.Get(name); value != "" {
+			ctx.Response().Header().Set(name, value)
+		}
+	}
+	ctx.Response().WriteHeader(download.StatusCode)
+
+	if _, err := io.Copy(ctx.Response(), download.Body); err != nil {
+		// Заголовки уже отправлены, сообщить клиенту об ошибке нельзя
+		fmt.Printf("Failed to send revision %d of work %s: %v\n", revision, workId, err)
+	}
+
+	return nil
+}
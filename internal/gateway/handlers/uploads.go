@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	gateway "sd_hw3/api/generated/gateway"
+	"sd_hw3/internal/gateway/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxCreateUploadBody наибольший размер запроса на создание загрузки
+const maxCreateUploadBody = 64 * 1024
+
+// CreateWorkUpload начинает загрузку работы частями
+func (h *Handler) CreateWorkUpload(ctx echo.Context) error {
+	// Тело - небольшой JSON; студент и задание нужны для проверки доступа
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxCreateUploadBody))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("VALIDATION_ERROR")),
+			Message:   stringPtr("Failed to read request body"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	var request gateway.CreateWorkUploadJSONRequestBody
+	if err := json.Unmarshal(body, &request); err != nil {
+		return ctx.JSON(http.StatusBadRequest, gateway.ApiError{
+			Error:     (*gateway.ApiErrorError)(stringPtr("VALIDATION_ERROR")),
+			Message:   stringPtr("Invalid request body"),
+			Timestamp: &[]time.Time{time.Now()}[0],
+		})
+	}
+	if !claimsFrom(ctx).CanAccess(request.StudentId, request.AssignmentId) {
+		return forbidden(ctx, "Cannot upload a work on behalf of another student")
+	}
+
+	resp, err := h.fileStorageService.CreateUpload(ctx.Request().Context(), bytes.NewReader(body))
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// GetWorkUploadOffset сообщает, сколько байт загрузки уже принято
+func (h *Handler) GetWorkUploadOffset(ctx echo.Context, uploadId string) error {
+	if err := h.checkUpload(ctx, uploadId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.GetUploadOffset(ctx.Request().Context(), uploadId)
+	if err != nil {
+		return ctx.NoContent(http.StatusServiceUnavailable)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// UploadWorkChunk передает часть работы в file-storage потоком
+func (h *Handler) UploadWorkChunk(ctx echo.Context, uploadId string, params gateway.UploadWorkChunkParams) error {
+	if err := h.checkUpload(ctx, uploadId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.UploadChunk(ctx.Request().Context(), uploadId, params.UploadOffset, ctx.Request().Body)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// CancelWorkUpload отменяет загрузку
+func (h *Handler) CancelWorkUpload(ctx echo.Context, uploadId string) error {
+	if err := h.checkUpload(ctx, uploadId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	resp, err := h.fileStorageService.CancelUpload(ctx.Request().Context(), uploadId)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+
+	return proxyUploadResponse(ctx, resp)
+}
+
+// CompleteWorkUpload завершает загрузку и, как SubmitWork, ставит работу в очередь анализа
+func (h *Handler) CompleteWorkUpload(ctx echo.Context, uploadId string) error {
+	if err := h.checkUpload(ctx, uploadId); err != nil {
+		return accessDenied(ctx, err)
+	}
+
+	uploadResp, resp, err := h.fileStorageService.CompleteUpload(ctx.Request().Context(), uploadId)
+	if err != nil {
+		return storageUnavailable(ctx)
+	}
+	if resp != nil {
+		return proxyUploadResponse(ctx, resp)
+	}
+
+	// Студент и задание известны file-storage, анализ получит их из метаданных файла
+	return h.queueAnalysis(ctx, []*models.WorkSubmissionResponse{uploadResp}, nil, nil)
+}
+
+// proxyUploadResponse передает клиенту ответ file-storage, заменяя адрес
+// загрузки в Location на адрес в gateway
+func proxyUploadResponse(ctx echo.Context, resp *models.UploadResponse) error {
+	header := ctx.Response().Header()
+	for name, values := range resp.Header {
+		header[name] = values
+	}
+	if location := resp.Header.Get(echo.HeaderLocation); strings.HasPrefix(location, "/uploads/") {
+		header.Set(echo.HeaderLocation, "/works"+location)
+	}
+
+	if len(resp.Body) == 0 {
+		return ctx.NoContent(resp.StatusCode)
+	}
+	return ctx.Blob(resp.StatusCode, resp.Header.Get(echo.HeaderContentType), resp.Body)
+}
+
+func storageUnavailable(ctx echo.Context) error {
+	return ctx.JSON(http.StatusServiceUnavailable, gateway.ApiError{
+		Error:     (*gateway.ApiErrorError)(stringPtr("SERVICE_UNAVAILABLE")),
+		Message:   stringPtr("File storage service unavailable"),
+		Timestamp: &[]time.Time{time.Now()}[0],
+	})
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -13,6 +15,7 @@ type WorkSubmissionResponse struct {
 	SizeBytes   int64     `json:"size_bytes,omitempty"`
 	UploadedAt  time.Time `json:"uploaded_at,omitempty"`
 	StoragePath string    `json:"storage_path,omitempty"`
+	Revision    int       `json:"revision,omitempty"`
 }
 
 type FileMetadata struct {
@@ -25,13 +28,56 @@ type FileMetadata struct {
 	SizeBytes    int64     `json:"size_bytes"`
 	UploadedAt   time.Time `json:"uploaded_at"`
 	Checksum     *string   `json:"checksum,omitempty"`
+	IsTemplate   bool      `json:"is_template"`
+}
+
+// Work работа студента по заданию в file-storage
+type Work struct {
+	WorkID       string `json:"work_id"`
+	StudentID    string `json:"student_id"`
+	AssignmentID string `json:"assignment_id"`
+	IsTemplate   bool   `json:"is_template"`
+}
+
+// UploadSession загрузка частями в file-storage
+type UploadSession struct {
+	UploadID     string `json:"upload_id"`
+	StudentID    string `json:"student_id"`
+	AssignmentID string `json:"assignment_id"`
+}
+
+// FileDownload ответ file-storage на скачивание файла: полное содержимое (200),
+// диапазон (206), 304 на условный запрос или 416 на недопустимый диапазон
+type FileDownload struct {
+	StatusCode int
+	Header     http.Header
+	Body       io.ReadCloser
+}
+
+// UploadResponse ответ file-storage на запрос к загрузке частями, передаваемый
+// клиенту как есть
+type UploadResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotentResponse ответ на отправку работы с ключом идемпотентности,
+// который хранится в file-storage и возвращается повторам запроса
+type IdempotentResponse struct {
+	StatusCode int
+	Body       []byte
+	WorkID     string
+	FileID     string
+	ReportID   string
 }
 
 type AnalysisRequest struct {
-	WorkID       string  `json:"work_id"`
-	FileID       string  `json:"file_id"`
-	StudentID    *string `json:"student_id,omitempty"`
-	AssignmentID *string `json:"assignment_id,omitempty"`
+	WorkID       string   `json:"work_id"`
+	FileID       string   `json:"file_id"`
+	FileIDs      []string `json:"file_ids,omitempty"`
+	StudentID    *string  `json:"student_id,omitempty"`
+	AssignmentID *string  `json:"assignment_id,omitempty"`
 }
 
 type Report struct {
@@ -46,6 +92,11 @@ type Report struct {
 	WordCount          *int          `json:"word_count,omitempty"`
 	AnalysisDurationMs *int          `json:"analysis_duration_ms,omitempty"`
 	Status             string        `json:"status"`
+	AnalysisMode       *string       `json:"analysis_mode,omitempty"`
+	Version            *int          `json:"version,omitempty"`
+	IsLatest           *bool         `json:"is_latest,omitempty"`
+	ResubmissionOf     *string       `json:"resubmission_of,omitempty"`
+	FileIDs            []string      `json:"file_ids,omitempty"`
 	ErrorMessage       *string       `json:"error_message,omitempty"`
 	CreatedAt          time.Time     `json:"created_at"`
 }
@@ -54,6 +105,9 @@ type SimilarWork struct {
 	WorkID               string   `json:"work_id"`
 	StudentID            *string  `json:"student_id,omitempty"`
 	SimilarityPercentage *float32 `json:"similarity_percentage,omitempty"`
+	IsSelfMatch          *bool    `json:"is_self_match,omitempty"`
+	OriginalPath         *string  `json:"original_path,omitempty"`
+	SimilarPath          *string  `json:"similar_path,omitempty"`
 }
 
 type ListReportsParams struct {
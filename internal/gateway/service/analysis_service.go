@@ -1,13 +1,13 @@
 package service
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
+	fileanalysis "sd_hw3/api/generated/file-analysis"
 	"sd_hw3/internal/gateway/models"
+	"sd_hw3/pkg/apiclient"
+	"sd_hw3/pkg/resilience"
 )
 
 type FileAnalysisService interface {
@@ -15,149 +15,130 @@ type FileAnalysisService interface {
 	GetReport(ctx context.Context, reportID string) (*models.Report, error)
 	GetWorkReports(ctx context.Context, workID string) ([]*models.Report, error)
 	ListReports(ctx context.Context, params *models.ListReportsParams) (*models.ReportListResponse, error)
+	GetReportDiff(ctx context.Context, reportID, similarID string) ([]byte, error)
+	// Health проверяет, что file-analysis отвечает
+	Health(ctx context.Context) error
+	// BreakerState состояние выключателя запросов к file-analysis
+	BreakerState() resilience.State
 }
 
 type fileAnalysisServiceImpl struct {
-	baseURL string
-	client  *http.Client
+	client *apiclient.FileAnalysis
 }
 
-func NewFileAnalysisService(baseURL string) FileAnalysisService {
-	return &fileAnalysisServiceImpl{
-		baseURL: baseURL,
-		client:  &http.Client{},
-	}
+// NewFileAnalysisService создает сервис поверх клиента file-analysis
+func NewFileAnalysisService(client *apiclient.FileAnalysis) FileAnalysisService {
+	return &fileAnalysisServiceImpl{client: client}
 }
 
 func (s *fileAnalysisServiceImpl) AnalyzeFile(ctx context.Context, req *models.AnalysisRequest) (*models.Report, error) {
-	url := fmt.Sprintf("%s/analyze", s.baseURL)
-
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	analysisReq := fileanalysis.AnalysisRequest{
+		WorkId:       req.WorkID,
+		FileId:       req.FileID,
+		StudentId:    req.StudentID,
+		AssignmentId: req.AssignmentID,
 	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if len(req.FileIDs) > 0 {
+		analysisReq.FileIds = &req.FileIDs
 	}
 
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	resp, err := s.client.Do(httpReq)
+	// Анализ выполняется асинхронно: в ответе отчет в статусе pending
+	report, err := s.client.AnalyzeFile(ctx, analysisReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("failed to queue analysis: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file analysis returned status: %d", resp.StatusCode)
-	}
-
-	var report models.Report
-	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &report, nil
+	return mapReport(report), nil
 }
 
 func (s *fileAnalysisServiceImpl) GetReport(ctx context.Context, reportID string) (*models.Report, error) {
-	url := fmt.Sprintf("%s/reports/%s", s.baseURL, reportID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := s.client.Do(req)
+	report, err := s.client.GetReport(ctx, reportID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get report: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file analysis returned status: %d", resp.StatusCode)
-	}
-
-	var report models.Report
-	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
-		return nil, fmt.Errorf("failed to decode report: %w", err)
-	}
-
-	return &report, nil
+	return mapReport(report), nil
 }
 
 func (s *fileAnalysisServiceImpl) GetWorkReports(ctx context.Context, workID string) ([]*models.Report, error) {
-	url := fmt.Sprintf("%s/reports/work/%s", s.baseURL, workID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := s.client.Do(req)
+	reports, err := s.client.GetWorkReports(ctx, workID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get work reports: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file analysis returned status: %d", resp.StatusCode)
-	}
-
-	var reports []*models.Report
-	if err := json.NewDecoder(resp.Body).Decode(&reports); err != nil {
-		return nil, fmt.Errorf("failed to decode reports: %w", err)
-	}
-
-	return reports, nil
+	return mapReports(reports), nil
 }
 
 func (s *fileAnalysisServiceImpl) ListReports(ctx context.Context, params *models.ListReportsParams) (*models.ReportListResponse, error) {
-	url := fmt.Sprintf("%s/reports", s.baseURL)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	list, err := s.client.ListReports(ctx, fileanalysis.ListReportsParams{
+		WorkId:       params.WorkID,
+		FileId:       params.FileID,
+		AssignmentId: params.AssignmentID,
+		StudentId:    params.StudentID,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Добавляем query параметры
-	q := req.URL.Query()
-	if params.WorkID != nil {
-		q.Add("work_id", *params.WorkID)
-	}
-	if params.FileID != nil {
-		q.Add("file_id", *params.FileID)
-	}
-	if params.AssignmentID != nil {
-		q.Add("assignment_id", *params.AssignmentID)
-	}
-	if params.StudentID != nil {
-		q.Add("student_id", *params.StudentID)
-	}
-	if params.Limit != nil {
-		q.Add("limit", fmt.Sprintf("%d", *params.Limit))
-	}
-	if params.Offset != nil {
-		q.Add("offset", fmt.Sprintf("%d", *params.Offset))
+		return nil, fmt.Errorf("failed to list reports: %w", err)
 	}
-	req.URL.RawQuery = q.Encode()
+	return &models.ReportListResponse{
+		Reports: mapReports(list.Reports),
+		Total:   list.Total,
+		Limit:   list.Limit,
+		Offset:  list.Offset,
+	}, nil
+}
 
-	resp, err := s.client.Do(req)
+func (s *fileAnalysisServiceImpl) GetReportDiff(ctx context.Context, reportID, similarID string) ([]byte, error) {
+	page, err := s.client.GetReportDiff(ctx, reportID, similarID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list reports: %w", err)
+		return nil, fmt.Errorf("failed to get report diff: %w", err)
 	}
-	defer resp.Body.Close()
+	return page, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file analysis returned status: %d", resp.StatusCode)
-	}
+func (s *fileAnalysisServiceImpl) Health(ctx context.Context) error {
+	return s.client.Health(ctx)
+}
 
-	var response models.ReportListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+func (s *fileAnalysisServiceImpl) BreakerState() resilience.State {
+	return s.client.BreakerState()
+}
+
+func mapReports(reports []fileanalysis.Report) []*models.Report {
+	result := make([]*models.Report, 0, len(reports))
+	for i := range reports {
+		result = append(result, mapReport(&reports[i]))
 	}
+	return result
+}
 
-	return &response, nil
+func mapReport(report *fileanalysis.Report) *models.Report {
+	result := &models.Report{
+		ReportID:           apiclient.Value(report.ReportId),
+		WorkID:             apiclient.Value(report.WorkId),
+		FileID:             apiclient.Value(report.FileId),
+		StudentID:          report.StudentId,
+		AssignmentID:       report.AssignmentId,
+		PlagiarismScore:    report.PlagiarismScore,
+		IsPlagiarism:       report.IsPlagiarism,
+		WordCount:          report.WordCount,
+		AnalysisDurationMs: report.AnalysisDurationMs,
+		Status:             string(apiclient.Value(report.Status)),
+		AnalysisMode:       report.AnalysisMode,
+		Version:            report.Version,
+		IsLatest:           report.IsLatest,
+		ResubmissionOf:     report.ResubmissionOf,
+		FileIDs:            apiclient.Value(report.FileIds),
+		ErrorMessage:       report.ErrorMessage,
+		CreatedAt:          apiclient.Value(report.CreatedAt),
+	}
+	for _, similar := range apiclient.Value(report.SimilarWorks) {
+		result.SimilarWorks = append(result.SimilarWorks, models.SimilarWork{
+			WorkID:               apiclient.Value(similar.WorkId),
+			StudentID:            similar.StudentId,
+			SimilarityPercentage: similar.SimilarityPercentage,
+			IsSelfMatch:          similar.IsSelfMatch,
+			OriginalPath:         similar.OriginalPath,
+			SimilarPath:          similar.SimilarPath,
+		})
+	}
+	return result
 }
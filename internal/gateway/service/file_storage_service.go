@@ -2,138 +2,365 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strconv"
 	"strings"
 
+	filestorage "sd_hw3/api/generated/file-storage"
 	"sd_hw3/internal/gateway/models"
+	"sd_hw3/pkg/apiclient"
+	"sd_hw3/pkg/resilience"
 )
 
 type FileStorageService interface {
-	UploadFile(ctx context.Context, studentID, assignmentID string, file *multipart.FileHeader) (*models.WorkSubmissionResponse, error)
-	DownloadFile(ctx context.Context, fileID string) (io.ReadCloser, string, int64, error)
+	// UploadFile загружает файл в ревизию revision работы студента; 0 создает новую ревизию
+	UploadFile(ctx context.Context, studentID, assignmentID string, revision int, file *multipart.FileHeader) (*models.WorkSubmissionResponse, error)
+	// DownloadFile скачивает файл; заголовки Range и условных запросов из header
+	// передаются в file-storage. Body ответа закрывает вызывающий.
+	DownloadFile(ctx context.Context, fileID string, header http.Header) (*models.FileDownload, error)
 	GetFileMetadata(ctx context.Context, fileID string) (*models.FileMetadata, error)
+	// GetWork и GetUpload получают владельца работы и загрузки частями для
+	// проверки доступа; если их нет, ошибка - apiclient.ErrNotFound
+	GetWork(ctx context.Context, workID string) (*models.Work, error)
+	GetUpload(ctx context.Context, uploadID string) (*models.UploadSession, error)
+	// CreateUpload, GetUploadOffset, UploadChunk и CancelUpload передают запросы
+	// загрузки частями в file-storage; ошибкой считается только недоступность сервиса
+	CreateUpload(ctx context.Context, body io.Reader) (*models.UploadResponse, error)
+	GetUploadOffset(ctx context.Context, uploadID string) (*models.UploadResponse, error)
+	UploadChunk(ctx context.Context, uploadID string, offset int64, body io.Reader) (*models.UploadResponse, error)
+	CancelUpload(ctx context.Context, uploadID string) (*models.UploadResponse, error)
+	// CompleteUpload завершает загрузку частями. Если file-storage отказал,
+	// вместо файла возвращается его ответ для передачи клиенту.
+	CompleteUpload(ctx context.Context, uploadID string) (*models.WorkSubmissionResponse, *models.UploadResponse, error)
+	// ListWorkRevisions, SetCurrentRevision и DiffWorkRevisions передают запросы
+	// к ревизиям работы в file-storage, ответ передается клиенту как есть
+	ListWorkRevisions(ctx context.Context, workID string) (*models.UploadResponse, error)
+	SetCurrentRevision(ctx context.Context, workID string, body io.Reader) (*models.UploadResponse, error)
+	DiffWorkRevisions(ctx context.Context, workID string, from, to int) (*models.UploadResponse, error)
+	// DownloadWorkRevision скачивает ревизию работы: файл или ZIP-архив с ее файлами
+	DownloadWorkRevision(ctx context.Context, workID string, revision int, header http.Header) (*models.FileDownload, error)
+	// DeleteWorkRevision удаляет последнюю ревизию работы, например отправку,
+	// загруженную не целиком
+	DeleteWorkRevision(ctx context.Context, workID string, revision int) error
+	// ReserveIdempotencyKey занимает ключ идемпотентности запроса с отпечатком
+	// requestHash. Возвращает nil, если запрос нужно выполнить, или ответ на уже
+	// выполненный запрос. Если запрос с ключом еще выполняется или ключ
+	// использован с другим запросом, ошибка - *apiclient.Error с кодом
+	// IDEMPOTENCY_KEY_IN_PROGRESS или IDEMPOTENCY_KEY_MISMATCH.
+	ReserveIdempotencyKey(ctx context.Context, keyID, requestHash string) (*models.IdempotentResponse, error)
+	// CompleteIdempotencyKey сохраняет ответ на запрос с ключом
+	CompleteIdempotencyKey(ctx context.Context, keyID, requestHash string, response *models.IdempotentResponse) error
+	// ReleaseIdempotencyKey освобождает ключ запроса, который не удался
+	ReleaseIdempotencyKey(ctx context.Context, keyID string) error
+	// Health проверяет, что file-storage отвечает
+	Health(ctx context.Context) error
+	// BreakerState состояние выключателя запросов к file-storage
+	BreakerState() resilience.State
 }
 
 type fileStorageServiceImpl struct {
-	baseURL string
-	client  *http.Client
+	client *apiclient.FileStorage
 }
 
-func NewFileStorageService(baseURL string) FileStorageService {
-	return &fileStorageServiceImpl{
-		baseURL: strings.TrimSuffix(baseURL, "/"),
-		client:  &http.Client{},
+// NewFileStorageService создает сервис поверх клиента file-storage
+func NewFileStorageService(client *apiclient.FileStorage) FileStorageService {
+	return &fileStorageServiceImpl{client: client}
+}
+
+// IsUploadRejected ошибка - отказ file-storage принять файл (4xx): тип не
+// разрешен, файл слишком большой и т.д.
+func IsUploadRejected(err error) (*apiclient.Error, bool) {
+	var apiErr *apiclient.Error
+	if errors.As(err, &apiErr) && apiErr.Rejected() {
+		return apiErr, true
 	}
+	return nil, false
 }
 
-func (s *fileStorageServiceImpl) UploadFile(ctx context.Context, studentID, assignmentID string, file *multipart.FileHeader) (*models.WorkSubmissionResponse, error) {
-	url := fmt.Sprintf("%s/files", s.baseURL)
+func (s *fileStorageServiceImpl) UploadFile(ctx context.Context, studentID, assignmentID string, revision int, file *multipart.FileHeader) (*models.WorkSubmissionResponse, error) {
+	// Тело multipart запроса формируется по мере отправки через pipe, поэтому
+	// файл не копируется в память целиком. Если запрос придется повторить,
+	// файл открывается заново; граница частей должна совпадать с Content-Type.
+	form := multipart.NewWriter(io.Discard)
+	newBody := func() (io.ReadCloser, error) {
+		src, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
 
-	// Открываем файл
-	src, err := file.Open()
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		body, pw := io.Pipe()
+		writer := multipart.NewWriter(pw)
+		writer.SetBoundary(form.Boundary())
+		go func() {
+			defer src.Close()
+			pw.CloseWithError(writeUploadForm(writer, studentID, assignmentID, revision, file, src))
+		}()
+		return body, nil
 	}
-	defer src.Close()
 
-	// Создаем multipart запрос
-	body := &strings.Builder{}
-	writer := multipart.NewWriter(body)
+	uploaded, err := s.client.UploadFile(ctx, form.FormDataContentType(), newBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	return mapUploadResponse(uploaded), nil
+}
 
+// writeUploadForm пишет поля формы и содержимое файла в multipart-запрос к file-storage.
+// Поля идут раньше файла: file-storage читает запрос потоково.
+func writeUploadForm(writer *multipart.Writer, studentID, assignmentID string, revision int, file *multipart.FileHeader, src io.Reader) error {
 	// Добавляем поля
-	writer.WriteField("student_id", studentID)
-	writer.WriteField("assignment_id", assignmentID)
+	if err := writer.WriteField("student_id", studentID); err != nil {
+		return err
+	}
+	if err := writer.WriteField("assignment_id", assignmentID); err != nil {
+		return err
+	}
+	if revision != 0 {
+		if err := writer.WriteField("revision", strconv.Itoa(revision)); err != nil {
+			return err
+		}
+	}
+
+	// Добавляем файл, сохраняя его content type
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(file.Filename)))
+	contentType := file.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
 
-	// Добавляем файл
-	part, err := writer.CreateFormFile("file", file.Filename)
+	part, err := writer.CreatePart(header)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create form file: %w", err)
+		return fmt.Errorf("failed to create form file: %w", err)
 	}
 
 	if _, err := io.Copy(part, src); err != nil {
-		return nil, fmt.Errorf("failed to copy file content: %w", err)
+		return fmt.Errorf("failed to copy file content: %w", err)
 	}
 
-	writer.Close()
+	return writer.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+// downloadRequestHeaders заголовки запроса клиента, передаваемые в file-storage
+// при скачивании: диапазоны и условия кэширования
+var downloadRequestHeaders = []string{"Range", "If-Range", "If-None-Match", "If-Modified-Since"}
 
-	// Создаем запрос
-	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(body.String()))
+func (s *fileStorageServiceImpl) DownloadFile(ctx context.Context, fileID string, header http.Header) (*models.FileDownload, error) {
+	resp, err := s.client.DownloadFile(ctx, fileID, downloadHeader(header))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to download file: %w", err)
 	}
+	return &models.FileDownload{StatusCode: resp.StatusCode, Header: resp.Header, Body: resp.Body}, nil
+}
 
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// Отправляем запрос
-	resp, err := s.client.Do(req)
+func (s *fileStorageServiceImpl) DownloadWorkRevision(ctx context.Context, workID string, revision int, header http.Header) (*models.FileDownload, error) {
+	resp, err := s.client.DownloadWorkRevision(ctx, workID, revision, downloadHeader(header))
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("failed to download revision: %w", err)
 	}
-	defer resp.Body.Close()
+	return &models.FileDownload{StatusCode: resp.StatusCode, Header: resp.Header, Body: resp.Body}, nil
+}
 
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
+func (s *fileStorageServiceImpl) DeleteWorkRevision(ctx context.Context, workID string, revision int) error {
+	if err := s.client.DeleteWorkRevision(ctx, workID, revision); err != nil {
+		return fmt.Errorf("failed to delete revision: %w", err)
 	}
+	return nil
+}
 
-	// Парсим ответ
-	var uploadResp models.WorkSubmissionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&uploadResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// downloadHeader заголовки downloadRequestHeaders из запроса клиента
+func downloadHeader(header http.Header) http.Header {
+	result := make(http.Header)
+	for _, name := range downloadRequestHeaders {
+		if value := header.Get(name); value != "" {
+			result.Set(name, value)
+		}
 	}
+	return result
+}
 
-	return &uploadResp, nil
+func (s *fileStorageServiceImpl) GetFileMetadata(ctx context.Context, fileID string) (*models.FileMetadata, error) {
+	metadata, err := s.client.GetFileMetadata(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file metadata: %w", err)
+	}
+	return &models.FileMetadata{
+		FileID:       apiclient.Value(metadata.FileId),
+		WorkID:       apiclient.Value(metadata.WorkId),
+		StudentID:    apiclient.Value(metadata.StudentId),
+		AssignmentID: apiclient.Value(metadata.AssignmentId),
+		Filename:     apiclient.Value(metadata.Filename),
+		ContentType:  apiclient.Value(metadata.ContentType),
+		SizeBytes:    apiclient.Value(metadata.SizeBytes),
+		UploadedAt:   apiclient.Value(metadata.UploadedAt),
+		Checksum:     metadata.Checksum,
+		IsTemplate:   apiclient.Value(metadata.IsTemplate),
+	}, nil
 }
 
-func (s *fileStorageServiceImpl) DownloadFile(ctx context.Context, fileID string) (io.ReadCloser, string, int64, error) {
-	url := fmt.Sprintf("%s/files/%s", s.baseURL, fileID)
+func (s *fileStorageServiceImpl) GetWork(ctx context.Context, workID string) (*models.Work, error) {
+	work, err := s.client.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	return &models.Work{
+		WorkID:       work.WorkId,
+		StudentID:    work.StudentId,
+		AssignmentID: work.AssignmentId,
+		IsTemplate:   work.IsTemplate,
+	}, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+func (s *fileStorageServiceImpl) GetUpload(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	upload, err := s.client.GetUpload(ctx, uploadID)
 	if err != nil {
-		return nil, "", 0, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to get upload: %w", err)
 	}
+	return &models.UploadSession{
+		UploadID:     upload.UploadId,
+		StudentID:    apiclient.Value(upload.StudentId),
+		AssignmentID: apiclient.Value(upload.AssignmentId),
+	}, nil
+}
 
-	resp, err := s.client.Do(req)
+func (s *fileStorageServiceImpl) ReserveIdempotencyKey(ctx context.Context, keyID, requestHash string) (*models.IdempotentResponse, error) {
+	key, reserved, err := s.client.ReserveIdempotencyKey(ctx, keyID, requestHash)
 	if err != nil {
-		return nil, "", 0, fmt.Errorf("failed to download file: %w", err)
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return nil, nil
 	}
+	return &models.IdempotentResponse{
+		StatusCode: apiclient.Value(key.ResponseStatus),
+		Body:       []byte(apiclient.Value(key.ResponseBody)),
+		WorkID:     apiclient.Value(key.WorkId),
+		FileID:     apiclient.Value(key.FileId),
+		ReportID:   apiclient.Value(key.ReportId),
+	}, nil
+}
+
+func (s *fileStorageServiceImpl) CompleteIdempotencyKey(ctx context.Context, keyID, requestHash string, response *models.IdempotentResponse) error {
+	err := s.client.CompleteIdempotencyKey(ctx, keyID, filestorage.IdempotencyKeyResponse{
+		RequestHash:    requestHash,
+		ResponseStatus: response.StatusCode,
+		ResponseBody:   string(response.Body),
+		WorkId:         optionalString(response.WorkID),
+		FileId:         optionalString(response.FileID),
+		ReportId:       optionalString(response.ReportID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		resp.Body.Close()
-		return nil, "", 0, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
+func (s *fileStorageServiceImpl) ReleaseIdempotencyKey(ctx context.Context, keyID string) error {
+	if err := s.client.ReleaseIdempotencyKey(ctx, keyID); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
 	}
+	return nil
+}
 
-	contentType := resp.Header.Get("Content-Type")
-	contentLength := resp.ContentLength
+func (s *fileStorageServiceImpl) Health(ctx context.Context) error {
+	return s.client.Health(ctx)
+}
 
-	return resp.Body, contentType, contentLength, nil
+func (s *fileStorageServiceImpl) BreakerState() resilience.State {
+	return s.client.BreakerState()
 }
 
-func (s *fileStorageServiceImpl) GetFileMetadata(ctx context.Context, fileID string) (*models.FileMetadata, error) {
-	url := fmt.Sprintf("%s/files/%s/metadata", s.baseURL, fileID)
+func (s *fileStorageServiceImpl) CreateUpload(ctx context.Context, body io.Reader) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.CreateUpload(ctx, body))
+}
+
+func (s *fileStorageServiceImpl) GetUploadOffset(ctx context.Context, uploadID string) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.GetUploadOffset(ctx, uploadID))
+}
+
+func (s *fileStorageServiceImpl) UploadChunk(ctx context.Context, uploadID string, offset int64, body io.Reader) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.UploadChunk(ctx, uploadID, offset, body))
+}
+
+func (s *fileStorageServiceImpl) CancelUpload(ctx context.Context, uploadID string) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.CancelUpload(ctx, uploadID))
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+func (s *fileStorageServiceImpl) CompleteUpload(ctx context.Context, uploadID string) (*models.WorkSubmissionResponse, *models.UploadResponse, error) {
+	uploaded, rejected, err := s.client.CompleteUpload(ctx, uploadID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to complete upload: %w", err)
 	}
+	if rejected != nil {
+		resp, _ := uploadResponse(rejected, nil)
+		return nil, resp, nil
+	}
+	return mapUploadResponse(uploaded), nil, nil
+}
+
+func (s *fileStorageServiceImpl) ListWorkRevisions(ctx context.Context, workID string) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.ListWorkRevisions(ctx, workID))
+}
+
+func (s *fileStorageServiceImpl) SetCurrentRevision(ctx context.Context, workID string, body io.Reader) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.SetCurrentRevision(ctx, workID, body))
+}
+
+func (s *fileStorageServiceImpl) DiffWorkRevisions(ctx context.Context, workID string, from, to int) (*models.UploadResponse, error) {
+	return uploadResponse(s.client.DiffWorkRevisions(ctx, workID, from, to))
+}
+
+// uploadResponseHeaders заголовки ответа file-storage, передаваемые клиенту при загрузке частями
+var uploadResponseHeaders = []string{"Content-Type", "Location", "Upload-Offset", "Upload-Length", "Upload-Expires", "Cache-Control"}
 
-	resp, err := s.client.Do(req)
+// uploadResponse ответ file-storage для передачи клиенту с заголовками uploadResponseHeaders
+func uploadResponse(resp *apiclient.Response, err error) (*models.UploadResponse, error) {
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file metadata: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("file storage returned status: %d", resp.StatusCode)
+	header := make(http.Header)
+	for _, name := range uploadResponseHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			header.Set(name, value)
+		}
 	}
 
-	var metadata models.FileMetadata
-	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
-		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	return &models.UploadResponse{
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       resp.Body,
+	}, nil
+}
+
+func mapUploadResponse(uploaded *filestorage.FileUploadResponse) *models.WorkSubmissionResponse {
+	return &models.WorkSubmissionResponse{
+		WorkID:      uploaded.WorkId,
+		FileID:      uploaded.FileId,
+		Filename:    apiclient.Value(uploaded.Filename),
+		SizeBytes:   int64(apiclient.Value(uploaded.SizeBytes)),
+		UploadedAt:  apiclient.Value(uploaded.UploadedAt),
+		StoragePath: apiclient.Value(uploaded.StoragePath),
+		Revision:    apiclient.Value(uploaded.Revision),
 	}
+}
 
-	return &metadata, nil
+// optionalString nil для пустой строки
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS extracted_texts;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS extracted_texts (
+    file_id VARCHAR(255) PRIMARY KEY,
+    extractor VARCHAR(50) NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS fingerprints;
\ No newline at end of file
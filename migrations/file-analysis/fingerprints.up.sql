@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS fingerprints (
+    file_id VARCHAR(255) NOT NULL,
+    report_id VARCHAR(255) NOT NULL,
+    assignment_id VARCHAR(255) NOT NULL,
+    hash BIGINT NOT NULL,
+    position INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_fingerprints_assignment_hash ON fingerprints(assignment_id, hash);
+CREATE INDEX IF NOT EXISTS idx_fingerprints_file_id ON fingerprints(file_id);
+CREATE INDEX IF NOT EXISTS idx_fingerprints_report_id ON fingerprints(report_id);
\ No newline at end of file
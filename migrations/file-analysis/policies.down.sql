@@ -0,0 +1 @@
+DROP TABLE IF EXISTS policies;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS policies (
+    assignment_id VARCHAR(255) PRIMARY KEY,
+    plagiarism_threshold DECIMAL(5,2) NOT NULL,
+    min_similarity_percentage DECIMAL(5,2) NOT NULL,
+    min_match_length INT NOT NULL,
+    algorithm VARCHAR(50) NOT NULL,
+    ignore_template_code BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE policies ADD COLUMN IF NOT EXISTS compare_all_revisions BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
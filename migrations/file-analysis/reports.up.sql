@@ -1,6 +1,7 @@
 CREATE TABLE IF NOT EXISTS reports (
     report_id VARCHAR(255) PRIMARY KEY,
     work_id VARCHAR(255) NOT NULL,
+    file_id VARCHAR(255) NOT NULL,
     student_id VARCHAR(255) NOT NULL,
     assignment_id VARCHAR(255) NOT NULL,
     plagiarism_score DECIMAL(5,2) DEFAULT 0.00,
@@ -10,4 +11,33 @@ CREATE TABLE IF NOT EXISTS reports (
     status VARCHAR(50) DEFAULT 'completed',
     error_message TEXT,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
\ No newline at end of file
+);
+
+CREATE INDEX IF NOT EXISTS idx_reports_assignment_id ON reports(assignment_id);
+
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS analysis_mode VARCHAR(50) DEFAULT 'text';
+
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS version INT DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS idx_reports_file_id_version ON reports(file_id, version);
+
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS resubmission_of VARCHAR(255);
+
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS file_ids TEXT[];
+
+-- Отчеты, получившие одинаковую версию до появления ограничения,
+-- перенумеровываются по времени создания
+UPDATE reports r SET version = numbered.version
+FROM (
+    SELECT report_id, ROW_NUMBER() OVER (PARTITION BY file_id ORDER BY created_at, report_id) AS version
+    FROM reports
+    WHERE file_id IN (SELECT file_id FROM reports GROUP BY file_id, version HAVING COUNT(*) > 1)
+) numbered
+WHERE r.report_id = numbered.report_id AND r.version != numbered.version;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'uq_reports_file_id_version') THEN
+        ALTER TABLE reports ADD CONSTRAINT uq_reports_file_id_version UNIQUE (file_id, version);
+    END IF;
+END $$;
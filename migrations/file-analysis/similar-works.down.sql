@@ -1 +1,2 @@
+DROP TABLE IF EXISTS matched_fragments;
 DROP TABLE IF EXISTS similar_works;
\ No newline at end of file
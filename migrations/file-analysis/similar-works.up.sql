@@ -5,4 +5,24 @@ CREATE TABLE IF NOT EXISTS similar_works (
     similar_work_id VARCHAR(255) NOT NULL,
     similarity_percentage DECIMAL(5,2) DEFAULT 0.00,
     FOREIGN KEY (report_id) REFERENCES reports(report_id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+ALTER TABLE similar_works ADD COLUMN IF NOT EXISTS is_self_match BOOLEAN DEFAULT FALSE;
+ALTER TABLE similar_works ADD COLUMN IF NOT EXISTS original_path VARCHAR(1000);
+ALTER TABLE similar_works ADD COLUMN IF NOT EXISTS similar_path VARCHAR(1000);
+
+CREATE TABLE IF NOT EXISTS matched_fragments (
+    fragment_id BIGSERIAL PRIMARY KEY,
+    similar_id VARCHAR(255) NOT NULL,
+    original_offset INT NOT NULL,
+    original_length INT NOT NULL,
+    original_start_line INT NOT NULL,
+    original_end_line INT NOT NULL,
+    similar_offset INT NOT NULL,
+    similar_length INT NOT NULL,
+    similar_start_line INT NOT NULL,
+    similar_end_line INT NOT NULL,
+    FOREIGN KEY (similar_id) REFERENCES similar_works(similar_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_matched_fragments_similar_id ON matched_fragments(similar_id);
\ No newline at end of file
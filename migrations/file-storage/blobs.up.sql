@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS blobs (
+    checksum_sha256 VARCHAR(64) PRIMARY KEY,
+    storage_path VARCHAR(1000) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    ref_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
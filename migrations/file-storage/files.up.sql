@@ -1,4 +1,4 @@
-CREATE TABLE files (
+CREATE TABLE IF NOT EXISTS files (
     file_id VARCHAR(255) PRIMARY KEY,
     work_id VARCHAR(255) NOT NULL,
     filename VARCHAR(500) NOT NULL,
@@ -10,4 +10,20 @@ CREATE TABLE files (
     checksum_sha256 VARCHAR(64),
     uploaded_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (work_id) REFERENCES works(work_id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+CREATE INDEX IF NOT EXISTS idx_files_checksum_sha256 ON files(checksum_sha256);
+
+ALTER TABLE files ADD COLUMN IF NOT EXISTS is_archive BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS parent_file_id VARCHAR(255) REFERENCES files(file_id) ON DELETE CASCADE;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS member_path VARCHAR(1000);
+
+CREATE INDEX IF NOT EXISTS idx_files_parent_file_id ON files(parent_file_id);
+
+ALTER TABLE files ADD COLUMN IF NOT EXISTS revision INT NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS idx_files_work_id_revision ON files(work_id, revision);
+
+-- Файлы, загруженные до появления ревизий, составляют первую ревизию работы
+UPDATE works SET latest_revision = 1, current_revision = 1
+WHERE latest_revision = 0 AND EXISTS (SELECT 1 FROM files WHERE files.work_id = works.work_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS upload_chunks;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS upload_chunks (
+    upload_id VARCHAR(255) NOT NULL,
+    offset_bytes BIGINT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_path VARCHAR(1000) NOT NULL,
+    PRIMARY KEY (upload_id, offset_bytes),
+    FOREIGN KEY (upload_id) REFERENCES upload_sessions(upload_id) ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS upload_policies;
\ No newline at end of file
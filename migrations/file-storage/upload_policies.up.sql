@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS upload_policies (
+    assignment_id VARCHAR(255) PRIMARY KEY,
+    allowed_types TEXT[] NOT NULL DEFAULT '{}',
+    max_file_size BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS upload_sessions (
+    upload_id VARCHAR(255) PRIMARY KEY,
+    student_id VARCHAR(255) NOT NULL,
+    assignment_id VARCHAR(255) NOT NULL,
+    filename VARCHAR(500) NOT NULL,
+    content_type VARCHAR(100),
+    size_bytes BIGINT NOT NULL,
+    offset_bytes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_upload_sessions_expires_at ON upload_sessions(expires_at);
+
+ALTER TABLE upload_sessions ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'open';
+
+ALTER TABLE upload_sessions ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP;
@@ -1,7 +1,12 @@
-CREATE TABLE works (
+CREATE TABLE IF NOT EXISTS works (
     work_id VARCHAR(255) PRIMARY KEY,
     student_id VARCHAR(255) NOT NULL,
     assignment_id VARCHAR(255) NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
\ No newline at end of file
+);
+
+ALTER TABLE works ADD COLUMN IF NOT EXISTS is_template BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE works ADD COLUMN IF NOT EXISTS latest_revision INT NOT NULL DEFAULT 0;
+ALTER TABLE works ADD COLUMN IF NOT EXISTS current_revision INT NOT NULL DEFAULT 0;
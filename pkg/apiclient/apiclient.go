@@ -0,0 +1,148 @@
+// Package apiclient клиенты file-storage и file-analysis поверх клиентов,
+// сгенерированных oapi-codegen по api/openapi/*.yaml. Все запросы
+// подписываются ключом сервиса (svcauth), ограничены по времени и проходят
+// через выключатель и повторы (resilience); ответы с ошибкой возвращаются
+// как *Error.
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"sd_hw3/pkg/resilience"
+	"sd_hw3/pkg/svcauth"
+)
+
+var (
+	// ErrNotFound сервис ответил 404
+	ErrNotFound = errors.New("not found")
+	// ErrUnavailable сервис недоступен, не ответил вовремя, ответил 5xx или
+	// выключатель запросов к нему разомкнут
+	ErrUnavailable = errors.New("service unavailable")
+)
+
+// Options параметры клиента
+type Options struct {
+	// Service имя вызывающего сервиса, которым подписываются запросы
+	Service string
+	// ServiceKey общий ключ подписи запросов между сервисами
+	ServiceKey []byte
+	// Timeout предельное время обычного запроса вместе с чтением ответа
+	Timeout time.Duration
+	// TransferTimeout предельное время передачи файла; 0 - без ограничения
+	TransferTimeout time.Duration
+	// Retry повторы запросов при недоступности сервиса
+	Retry resilience.RetryPolicy
+	// Breaker выключатель запросов к сервису; свой у каждого клиента
+	Breaker resilience.BreakerConfig
+}
+
+// httpClient HTTP-клиент с подписью запросов, повторами через breaker и
+// ограничением времени timeout (вместе с повторами)
+func (o Options) httpClient(timeout time.Duration, breaker *resilience.Breaker) *http.Client {
+	signed := svcauth.NewTransport(o.Service, o.ServiceKey, http.DefaultTransport)
+	return &http.Client{
+		Transport: resilience.NewTransport(signed, o.Retry, breaker),
+		Timeout:   timeout,
+	}
+}
+
+// Error ответ сервиса с кодом ошибки
+type Error struct {
+	Service    string
+	StatusCode int
+	// Code и Message из тела ответа (ApiError), если оно есть
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s returned status %d", e.Service, e.StatusCode)
+	}
+	return fmt.Sprintf("%s returned status %d: %s: %s", e.Service, e.StatusCode, e.Code, e.Message)
+}
+
+// Is позволяет проверять ошибку через errors.Is(err, ErrNotFound) и ErrUnavailable
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnavailable:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Rejected сервис отклонил запрос как недопустимый (4xx)
+func (e *Error) Rejected() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500
+}
+
+// responseError ошибка по ответу с неожиданным статусом
+func responseError(service string, resp *http.Response, body []byte) error {
+	if resp.StatusCode < 300 {
+		return fmt.Errorf("%s returned unexpected response: status %d, content type %q",
+			service, resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	apiErr := &Error{Service: service, StatusCode: resp.StatusCode}
+	var parsed struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &parsed) == nil {
+		apiErr.Code, apiErr.Message = parsed.Error, parsed.Message
+	}
+	return apiErr
+}
+
+// streamError ошибка по потоковому ответу; тело читается частично и закрывается
+func streamError(service string, resp *http.Response) error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	return responseError(service, resp, body)
+}
+
+// requestError ошибка отправки запроса или разбора ответа
+func requestError(service string, err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("%w: %s: %w", ErrUnavailable, service, err)
+	}
+	return fmt.Errorf("%s: %w", service, err)
+}
+
+// checkHealth проверяет, что сервис по адресу server отвечает на /health
+func checkHealth(ctx context.Context, client *http.Client, server, service string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+"/health", nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", service, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return requestError(service, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return streamError(service, resp)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Value значение необязательного поля сгенерированных типов или нулевое
+// значение, если поле не задано
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,123 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fileanalysis "sd_hw3/api/generated/file-analysis"
+	"sd_hw3/pkg/resilience"
+)
+
+const fileAnalysisName = "file-analysis"
+
+// ReportList страница списка отчетов
+type ReportList struct {
+	Reports []fileanalysis.Report
+	Total   int
+	Limit   int
+	Offset  int
+}
+
+// FileAnalysis клиент file-analysis
+type FileAnalysis struct {
+	server  string
+	client  *http.Client
+	breaker *resilience.Breaker
+	api     *fileanalysis.ClientWithResponses
+}
+
+// NewFileAnalysis создает клиент file-analysis по адресу baseURL
+func NewFileAnalysis(baseURL string, opts Options) (*FileAnalysis, error) {
+	breaker := resilience.NewBreaker(fileAnalysisName, opts.Breaker)
+	client := opts.httpClient(opts.Timeout, breaker)
+	api, err := fileanalysis.NewClientWithResponses(baseURL, fileanalysis.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file analysis client: %w", err)
+	}
+	return &FileAnalysis{server: baseURL, client: client, breaker: breaker, api: api}, nil
+}
+
+// Health проверяет, что file-analysis отвечает
+func (c *FileAnalysis) Health(ctx context.Context) error {
+	return checkHealth(ctx, c.client, c.server, fileAnalysisName)
+}
+
+// BreakerState состояние выключателя запросов к file-analysis
+func (c *FileAnalysis) BreakerState() resilience.State {
+	return c.breaker.State()
+}
+
+// AnalyzeFile ставит работу в очередь анализа; возвращается отчет в статусе pending
+func (c *FileAnalysis) AnalyzeFile(ctx context.Context, req fileanalysis.AnalysisRequest) (*fileanalysis.Report, error) {
+	resp, err := c.api.AnalyzeFileWithResponse(ctx, req)
+	if err != nil {
+		return nil, requestError(fileAnalysisName, err)
+	}
+	if resp.JSON202 == nil {
+		return nil, responseError(fileAnalysisName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON202, nil
+}
+
+// GetReport получает отчет
+func (c *FileAnalysis) GetReport(ctx context.Context, reportID string) (*fileanalysis.Report, error) {
+	resp, err := c.api.GetReportWithResponse(ctx, reportID)
+	if err != nil {
+		return nil, requestError(fileAnalysisName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileAnalysisName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetWorkReports отчеты по работе
+func (c *FileAnalysis) GetWorkReports(ctx context.Context, workID string) ([]fileanalysis.Report, error) {
+	resp, err := c.api.GetWorkReportsWithResponse(ctx, workID)
+	if err != nil {
+		return nil, requestError(fileAnalysisName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileAnalysisName, resp.HTTPResponse, resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+// ListReports список отчетов с фильтрами params
+func (c *FileAnalysis) ListReports(ctx context.Context, params fileanalysis.ListReportsParams) (*ReportList, error) {
+	resp, err := c.api.ListReportsWithResponse(ctx, &params)
+	if err != nil {
+		return nil, requestError(fileAnalysisName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileAnalysisName, resp.HTTPResponse, resp.Body)
+	}
+
+	list := &ReportList{}
+	if resp.JSON200.Reports != nil {
+		list.Reports = *resp.JSON200.Reports
+	}
+	if resp.JSON200.Total != nil {
+		list.Total = *resp.JSON200.Total
+	}
+	if resp.JSON200.Limit != nil {
+		list.Limit = *resp.JSON200.Limit
+	}
+	if resp.JSON200.Offset != nil {
+		list.Offset = *resp.JSON200.Offset
+	}
+	return list, nil
+}
+
+// GetReportDiff HTML-страница сравнения работы с похожей
+func (c *FileAnalysis) GetReportDiff(ctx context.Context, reportID, similarID string) ([]byte, error) {
+	resp, err := c.api.GetReportDiffWithResponse(ctx, reportID, similarID)
+	if err != nil {
+		return nil, requestError(fileAnalysisName, err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, responseError(fileAnalysisName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,334 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	filestorage "sd_hw3/api/generated/file-storage"
+	"sd_hw3/pkg/resilience"
+)
+
+const fileStorageName = "file-storage"
+
+// Response ответ сервиса, который передается клиенту как есть
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func newResponse(resp *http.Response, body []byte) *Response {
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}
+}
+
+// FileStorage клиент file-storage
+type FileStorage struct {
+	server  string
+	client  *http.Client
+	breaker *resilience.Breaker
+	api     *filestorage.ClientWithResponses
+	// transfer для запросов с содержимым файлов: их время ограничено отдельно
+	transfer *filestorage.ClientWithResponses
+}
+
+// NewFileStorage создает клиент file-storage по адресу baseURL
+func NewFileStorage(baseURL string, opts Options) (*FileStorage, error) {
+	breaker := resilience.NewBreaker(fileStorageName, opts.Breaker)
+	client := opts.httpClient(opts.Timeout, breaker)
+	api, err := filestorage.NewClientWithResponses(baseURL, filestorage.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file storage client: %w", err)
+	}
+	transfer, err := filestorage.NewClientWithResponses(baseURL, filestorage.WithHTTPClient(opts.httpClient(opts.TransferTimeout, breaker)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file storage client: %w", err)
+	}
+	return &FileStorage{server: baseURL, client: client, breaker: breaker, api: api, transfer: transfer}, nil
+}
+
+// Health проверяет, что file-storage отвечает
+func (c *FileStorage) Health(ctx context.Context) error {
+	return checkHealth(ctx, c.client, c.server, fileStorageName)
+}
+
+// BreakerState состояние выключателя запросов к file-storage
+func (c *FileStorage) BreakerState() resilience.State {
+	return c.breaker.State()
+}
+
+// UploadFile загружает файл; newBody создает multipart-форму с типом
+// contentType и вызывается снова, если запрос приходится повторить
+func (c *FileStorage) UploadFile(ctx context.Context, contentType string, newBody func() (io.ReadCloser, error)) (*filestorage.FileUploadResponse, error) {
+	body, err := newBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request body: %w", err)
+	}
+	resp, err := c.transfer.UploadFileWithBodyWithResponse(ctx, contentType, body, replayBody(newBody))
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
+// GetFileMetadata получает метаданные файла
+func (c *FileStorage) GetFileMetadata(ctx context.Context, fileID string) (*filestorage.FileMetadata, error) {
+	resp, err := c.api.GetFileMetadataWithResponse(ctx, fileID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetFileContent получает содержимое файла целиком (внутренний маршрут для анализа)
+func (c *FileStorage) GetFileContent(ctx context.Context, fileID string) ([]byte, error) {
+	resp, err := c.transfer.GetFileContentInternalWithResponse(ctx, fileID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.Body, nil
+}
+
+// CheckFileExists файлы других студентов с тем же содержимым
+func (c *FileStorage) CheckFileExists(ctx context.Context, fileID string) ([]string, error) {
+	resp, err := c.api.CheckFileExistsWithResponse(ctx, fileID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Files, nil
+}
+
+// ListTemplates шаблонные файлы задания
+func (c *FileStorage) ListTemplates(ctx context.Context, assignmentID string) ([]filestorage.FileMetadata, error) {
+	resp, err := c.api.ListTemplatesWithResponse(ctx, assignmentID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Files, nil
+}
+
+// ListArchiveMembers файлы, распакованные из архива
+func (c *FileStorage) ListArchiveMembers(ctx context.Context, fileID string) ([]filestorage.FileMetadata, error) {
+	resp, err := c.api.ListArchiveMembersWithResponse(ctx, fileID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Files, nil
+}
+
+// GetWork получает работу
+func (c *FileStorage) GetWork(ctx context.Context, workID string) (*filestorage.Work, error) {
+	resp, err := c.api.GetWorkWithResponse(ctx, workID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetUpload получает загрузку частями
+func (c *FileStorage) GetUpload(ctx context.Context, uploadID string) (*filestorage.UploadSession, error) {
+	resp, err := c.api.GetUploadWithResponse(ctx, uploadID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ReserveIdempotencyKey занимает ключ идемпотентности запроса. Возвращает
+// true, если запрос нужно выполнить, и false с сохраненным ответом, если он
+// уже выполнен.
+func (c *FileStorage) ReserveIdempotencyKey(ctx context.Context, keyID, requestHash string) (*filestorage.IdempotencyKey, bool, error) {
+	resp, err := c.api.ReserveIdempotencyKeyWithResponse(ctx, filestorage.IdempotencyKeyRequest{
+		KeyId:       keyID,
+		RequestHash: requestHash,
+	})
+	if err != nil {
+		return nil, false, requestError(fileStorageName, err)
+	}
+	switch {
+	case resp.JSON201 != nil:
+		return resp.JSON201, true, nil
+	case resp.JSON200 != nil:
+		return resp.JSON200, false, nil
+	}
+	return nil, false, responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+}
+
+// CompleteIdempotencyKey сохраняет ответ на запрос с ключом идемпотентности
+func (c *FileStorage) CompleteIdempotencyKey(ctx context.Context, keyID string, response filestorage.IdempotencyKeyResponse) error {
+	resp, err := c.api.CompleteIdempotencyKeyWithResponse(ctx, keyID, response)
+	if err != nil {
+		return requestError(fileStorageName, err)
+	}
+	if resp.JSON200 == nil {
+		return responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ запроса, который не удался
+func (c *FileStorage) ReleaseIdempotencyKey(ctx context.Context, keyID string) error {
+	resp, err := c.api.ReleaseIdempotencyKeyWithResponse(ctx, keyID)
+	if err != nil {
+		return requestError(fileStorageName, err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// DeleteWorkRevision удаляет последнюю ревизию работы
+func (c *FileStorage) DeleteWorkRevision(ctx context.Context, workID string, revision int) error {
+	resp, err := c.api.DeleteWorkRevisionWithResponse(ctx, workID, revision)
+	if err != nil {
+		return requestError(fileStorageName, err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(fileStorageName, resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// DownloadFile скачивает файл потоком; header дополняет запрос (Range и
+// условные заголовки). Ответы 200, 206, 304 и 416 возвращаются как есть,
+// Body закрывает вызывающий.
+func (c *FileStorage) DownloadFile(ctx context.Context, fileID string, header http.Header) (*http.Response, error) {
+	resp, err := c.transfer.GetFile(ctx, fileID, withHeader(header))
+	return download(resp, err)
+}
+
+// DownloadWorkRevision скачивает ревизию работы потоком, как DownloadFile
+func (c *FileStorage) DownloadWorkRevision(ctx context.Context, workID string, revision int, header http.Header) (*http.Response, error) {
+	resp, err := c.transfer.DownloadWorkRevision(ctx, workID, revision, withHeader(header))
+	return download(resp, err)
+}
+
+func download(resp *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusNotModified, http.StatusRequestedRangeNotSatisfiable:
+		return resp, nil
+	}
+	return nil, streamError(fileStorageName, resp)
+}
+
+// replayBody позволяет повторить запрос с телом, которое читается потоком
+func replayBody(newBody func() (io.ReadCloser, error)) filestorage.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.GetBody = newBody
+		return nil
+	}
+}
+
+func withHeader(header http.Header) filestorage.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		return nil
+	}
+}
+
+// CreateUpload, GetUploadOffset, UploadChunk, CancelUpload, ListWorkRevisions,
+// SetCurrentRevision и DiffWorkRevisions возвращают ответ file-storage как
+// есть; ошибка - только если сервис недоступен
+
+func (c *FileStorage) CreateUpload(ctx context.Context, body io.Reader) (*Response, error) {
+	resp, err := c.api.CreateUploadWithBodyWithResponse(ctx, "application/json", body)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+func (c *FileStorage) GetUploadOffset(ctx context.Context, uploadID string) (*Response, error) {
+	resp, err := c.api.GetUploadOffsetWithResponse(ctx, uploadID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+func (c *FileStorage) UploadChunk(ctx context.Context, uploadID string, offset int64, body io.Reader) (*Response, error) {
+	params := &filestorage.UploadChunkParams{UploadOffset: offset}
+	resp, err := c.transfer.UploadChunkWithBodyWithResponse(ctx, uploadID, params, "application/offset+octet-stream", body)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+func (c *FileStorage) CancelUpload(ctx context.Context, uploadID string) (*Response, error) {
+	resp, err := c.api.CancelUploadWithResponse(ctx, uploadID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+// CompleteUpload завершает загрузку частями. Если file-storage отказал,
+// вместо файла возвращается его ответ.
+func (c *FileStorage) CompleteUpload(ctx context.Context, uploadID string) (*filestorage.FileUploadResponse, *Response, error) {
+	// Завершение сохраняет и распаковывает весь файл, это передача файла
+	resp, err := c.transfer.CompleteUploadWithResponse(ctx, uploadID)
+	if err != nil {
+		return nil, nil, requestError(fileStorageName, err)
+	}
+	if resp.JSON201 == nil {
+		return nil, newResponse(resp.HTTPResponse, resp.Body), nil
+	}
+	return resp.JSON201, nil, nil
+}
+
+func (c *FileStorage) ListWorkRevisions(ctx context.Context, workID string) (*Response, error) {
+	resp, err := c.api.ListWorkRevisionsWithResponse(ctx, workID)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+func (c *FileStorage) SetCurrentRevision(ctx context.Context, workID string, body io.Reader) (*Response, error) {
+	resp, err := c.api.SetCurrentRevisionWithBodyWithResponse(ctx, workID, "application/json", body)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
+
+func (c *FileStorage) DiffWorkRevisions(ctx context.Context, workID string, from, to int) (*Response, error) {
+	params := &filestorage.DiffWorkRevisionsParams{From: from, To: to}
+	resp, err := c.api.DiffWorkRevisionsWithResponse(ctx, workID, params)
+	if err != nil {
+		return nil, requestError(fileStorageName, err)
+	}
+	return newResponse(resp.HTTPResponse, resp.Body), nil
+}
@@ -27,35 +27,11 @@ func Connect(dsn string) error {
 	return nil
 }
 
-func checkMigrationsApplied() (bool, error) {
-	// Проверяем существование ключевой таблицы
-	var tableExists bool
-	err := DB.QueryRow(`
-        SELECT EXISTS (
-            SELECT FROM information_schema.tables 
-            WHERE table_schema = 'public' 
-            AND table_name = 'works'
-        )
-    `).Scan(&tableExists)
-
-	if err != nil {
-		return false, fmt.Errorf("failed to check table existence: %w", err)
-	}
-
-	return tableExists, nil
-}
-
-// Migrate runs all .up.sql migrations in the given directory
+// Migrate runs all .up.sql migrations in the given directory. Migrations run
+// on every start, so they must be idempotent (CREATE TABLE IF NOT EXISTS,
+// ALTER TABLE ... ADD COLUMN IF NOT EXISTS): this is how already deployed
+// databases receive new tables and columns.
 func Migrate(migrationsDir string) error {
-	applied, err := checkMigrationsApplied()
-	if err != nil {
-		return err
-	}
-
-	if applied {
-		fmt.Println("Migrations already applied")
-		return nil
-	}
 	entries, err := os.ReadDir(migrationsDir)
 	if err != nil {
 		return fmt.Errorf("failed to read migrations directory: %w", err)
@@ -0,0 +1,131 @@
+// Package resilience делает вызовы между сервисами устойчивыми к временной
+// недоступности: повторяет запросы с экспоненциальной задержкой, размыкает
+// автоматический выключатель (circuit breaker), если сервис раз за разом не
+// отвечает, и передает вызываемому сервису оставшееся время запроса.
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen выключатель разомкнут: запрос не отправлялся
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// State состояние выключателя
+type State int
+
+const (
+	// StateClosed запросы проходят
+	StateClosed State = iota
+	// StateOpen запросы отклоняются сразу, не дожидаясь сервиса
+	StateOpen
+	// StateHalfOpen пропускается один пробный запрос; его исход замыкает
+	// или снова размыкает выключатель
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// BreakerConfig параметры выключателя
+type BreakerConfig struct {
+	// FailureThreshold сколько сбоев подряд размыкает выключатель
+	FailureThreshold int
+	// OpenTimeout сколько выключатель остается разомкнутым до пробного запроса
+	OpenTimeout time.Duration
+}
+
+// Breaker автоматический выключатель запросов к одному сервису
+type Breaker struct {
+	name string
+	cfg  BreakerConfig
+	now  func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	// probing пробный запрос в состоянии StateHalfOpen уже отправлен
+	probing bool
+}
+
+// NewBreaker создает замкнутый выключатель запросов к сервису name
+func NewBreaker(name string, cfg BreakerConfig) *Breaker {
+	cfg.FailureThreshold = max(cfg.FailureThreshold, 1)
+	return &Breaker{name: name, cfg: cfg, now: time.Now}
+}
+
+// Name имя сервиса
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State текущее состояние; разомкнутый выключатель, у которого истек
+// OpenTimeout, считается полуоткрытым
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		return StateHalfOpen
+	}
+	return b.state
+}
+
+// Allow разрешает запрос или возвращает ErrCircuitOpen. Исход разрешенного
+// запроса передается в Success, Failure или Release.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		b.state = StateHalfOpen
+		b.probing = false
+	}
+	switch b.state {
+	case StateOpen:
+		return ErrCircuitOpen
+	case StateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Success сервис ответил: выключатель замыкается
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = StateClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// Failure сервис недоступен или не ответил вовремя
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = b.now()
+		b.probing = false
+	}
+}
+
+// Release запрос отменен вызывающим, о сервисе ничего не известно
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
@@ -0,0 +1,33 @@
+package resilience
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TimeoutHeader оставшееся время запроса вызывающего сервиса в миллисекундах.
+// Передается относительным, а не моментом времени, чтобы не зависеть от
+// расхождения часов между сервисами.
+const TimeoutHeader = "X-Request-Timeout"
+
+// Deadline middleware, ограничивающий контекст запроса временем из
+// TimeoutHeader: сервис не продолжает работу, результат которой вызывающему
+// уже не нужен
+func Deadline() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ms, err := strconv.ParseInt(c.Request().Header.Get(TimeoutHeader), 10, 64)
+			if err != nil || ms <= 0 {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), time.Duration(ms)*time.Millisecond)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy параметры повторов
+type RetryPolicy struct {
+	// MaxAttempts наибольшее число попыток вместе с первой; 1 - без повторов
+	MaxAttempts int
+	// BaseDelay задержка перед первым повтором; каждая следующая вдвое больше
+	BaseDelay time.Duration
+	// MaxDelay наибольшая задержка между попытками
+	MaxDelay time.Duration
+}
+
+// Backoff задержка перед повтором после попытки attempt (с единицы):
+// экспоненциальная, не больше MaxDelay, со случайной половиной, чтобы
+// клиенты не повторяли запросы одновременно
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+	return Jitter(delay)
+}
+
+// Jitter случайная задержка от половины delay до delay
+func Jitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// idempotentMethods методы, повтор которых не меняет результат
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+type transport struct {
+	base    http.RoundTripper
+	retry   RetryPolicy
+	breaker *Breaker
+}
+
+// NewTransport транспорт поверх base, который пропускает запросы через
+// выключатель breaker и повторяет их по политике retry. Повторяются запросы
+// идемпотентными методами, получившие сетевую ошибку или 500, 502, 503, 504, и
+// любые запросы, которые не удалось отправить (сервис не принял соединение).
+// Тело повторяемого запроса должно воспроизводиться через GetBody.
+func NewTransport(base http.RoundTripper, retry RetryPolicy, breaker *Breaker) http.RoundTripper {
+	retry.MaxAttempts = max(retry.MaxAttempts, 1)
+	return &transport{base: base, retry: retry, breaker: breaker}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if err := t.breaker.Allow(); err != nil {
+			if attempt == 1 && req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, fmt.Errorf("%s: %w", t.breaker.Name(), err)
+		}
+
+		attemptReq, err := t.prepare(req, attempt)
+		if err != nil {
+			t.breaker.Release()
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		failed := t.record(ctx, resp, err)
+
+		retry := failed && attempt < t.retry.MaxAttempts && replayable(req) &&
+			(idempotentMethods[req.Method] || notSent(err))
+		if !retry {
+			return resp, err
+		}
+
+		delay := t.retry.Backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return resp, err
+		}
+		if resp != nil {
+			// Соединение можно использовать повторно, только дочитав тело
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// prepare копия запроса для попытки attempt с оставшимся временем в
+// заголовке TimeoutHeader; со второй попытки тело берется из GetBody
+func (t *transport) prepare(req *http.Request, attempt int) (*http.Request, error) {
+	attemptReq := req.Clone(req.Context())
+	if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay request body: %w", err)
+		}
+		attemptReq.Body = body
+	}
+	if deadline, ok := req.Context().Deadline(); ok {
+		remaining := max(time.Until(deadline).Milliseconds(), 1)
+		attemptReq.Header.Set(TimeoutHeader, strconv.FormatInt(remaining, 10))
+	}
+	return attemptReq, nil
+}
+
+// record передает исход попытки выключателю; возвращает true, если сервис
+// недоступен
+func (t *transport) record(ctx context.Context, resp *http.Response, err error) bool {
+	switch {
+	case err == nil && unavailableStatus(resp.StatusCode):
+		t.breaker.Failure()
+		return true
+	case err == nil:
+		t.breaker.Success()
+		return false
+	case errors.Is(ctx.Err(), context.Canceled):
+		// Запрос отменил вызывающий, сервис тут ни при чем
+		t.breaker.Release()
+		return false
+	}
+	t.breaker.Failure()
+	return ctx.Err() == nil
+}
+
+// unavailableStatus ответ означает сбой сервиса. 500 тоже считается сбоем:
+// упавший обработчик должен размыкать выключатель так же, как недоступный сервис
+func unavailableStatus(code int) bool {
+	return code == http.StatusInternalServerError || code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// replayable тело запроса можно отправить повторно
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// notSent запрос не был отправлен: не удалось установить соединение
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
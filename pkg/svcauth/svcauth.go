@@ -0,0 +1,214 @@
+// Package svcauth подписывает запросы между сервисами HMAC-SHA256 общим
+// ключом и проверяет подпись на принимающей стороне. Подписываются метод,
+// путь с параметрами, имя вызывающего сервиса, время запроса и SHA-256 тела
+// (заголовок HeaderContentSHA256), если тело буферизовано: его длина известна
+// и не больше MaxSignedBodySize. Тело, которое передается потоком (загрузка
+// файла), не подписывается. Повтор перехваченного запроса ограничен окном
+// MaxSkew.
+package svcauth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Заголовки подписанного запроса
+const (
+	HeaderService   = "X-Service-Name"
+	HeaderTimestamp = "X-Service-Timestamp"
+	HeaderSignature = "X-Service-Signature"
+	// HeaderContentSHA256 SHA-256 буферизованного тела запроса в hex
+	HeaderContentSHA256 = "X-Service-Content-SHA256"
+)
+
+// MaxSkew на сколько время запроса может отличаться от времени получателя
+const MaxSkew = 5 * time.Minute
+
+// MaxSignedBodySize наибольший размер подписываемого тела: Verify читает
+// подписанное тело в память целиком
+const MaxSignedBodySize = 1 << 20
+
+var (
+	// ErrUnsigned в запросе нет подписи
+	ErrUnsigned = errors.New("request is not signed")
+	// ErrInvalidSignature подпись не совпадает
+	ErrInvalidSignature = errors.New("invalid request signature")
+	// ErrExpired время запроса вне окна MaxSkew
+	ErrExpired = errors.New("request signature expired")
+	// ErrServiceNotAllowed вызывающему сервису нельзя обращаться к получателю
+	ErrServiceNotAllowed = errors.New("service not allowed")
+	// ErrBodyMismatch тело запроса не совпадает с подписанным
+	ErrBodyMismatch = errors.New("request body does not match signature")
+)
+
+// Sign подписывает запрос сервиса service ключом key. Тело подписывается,
+// если его нет или оно буферизовано (см. buffered).
+func Sign(req *http.Request, service string, key []byte, now time.Time) error {
+	digest := ""
+	if req.Body == nil || req.Body == http.NoBody || buffered(req) {
+		var err error
+		if digest, err = bodyDigest(req); err != nil {
+			return fmt.Errorf("failed to sign request body: %w", err)
+		}
+		req.Header.Set(HeaderContentSHA256, digest)
+	} else {
+		req.Header.Del(HeaderContentSHA256)
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(HeaderService, service)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, signature(key, req.Method, req.URL.RequestURI(), service, timestamp, digest))
+	return nil
+}
+
+// buffered тело запроса лежит в памяти: его длина известна, не больше
+// MaxSignedBodySize, и его можно прочитать повторно через GetBody. Тело
+// загрузки файла передается потоком неизвестной длины, даже если GetBody
+// задан для повторов.
+func buffered(req *http.Request) bool {
+	return req.GetBody != nil && req.ContentLength > 0 && req.ContentLength <= MaxSignedBodySize
+}
+
+// bodyDigest SHA-256 тела запроса, прочитанного через GetBody, не трогая req.Body
+func bodyDigest(req *http.Request) (string, error) {
+	hash := sha256.New()
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+		if _, err := io.Copy(hash, body); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Verify проверяет подпись входящего запроса и возвращает имя вызывающего сервиса
+func Verify(req *http.Request, key []byte, now time.Time) (string, error) {
+	service := req.Header.Get(HeaderService)
+	timestamp := req.Header.Get(HeaderTimestamp)
+	sig := req.Header.Get(HeaderSignature)
+	if service == "" || timestamp == "" || sig == "" {
+		return "", ErrUnsigned
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: bad timestamp", ErrInvalidSignature)
+	}
+	if skew := now.Sub(time.Unix(unix, 0)); skew > MaxSkew || skew < -MaxSkew {
+		return "", ErrExpired
+	}
+
+	digest := req.Header.Get(HeaderContentSHA256)
+	expected := signature(key, req.Method, req.RequestURI, service, timestamp, digest)
+	if !hmac.Equal([]byte(sig), []byte(expected)) {
+		return "", ErrInvalidSignature
+	}
+
+	// Тело читается только после проверки подписи, чтобы неподписанный
+	// запрос не мог заставить сервис буферизовать его в памяти
+	if digest != "" {
+		if err := verifyBody(req, digest); err != nil {
+			return "", err
+		}
+	}
+	return service, nil
+}
+
+// verifyBody сверяет тело запроса с подписанным digest и подменяет req.Body
+// прочитанной копией, чтобы обработчик мог его прочитать. Читается не больше
+// MaxSignedBodySize байт: подписанное тело больше не бывает.
+func verifyBody(req *http.Request, digest string) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(io.LimitReader(req.Body, MaxSignedBodySize+1))
+		req.Body.Close()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrBodyMismatch, err)
+		}
+		if len(body) > MaxSignedBodySize {
+			return fmt.Errorf("%w: more than %d bytes", ErrBodyMismatch, MaxSignedBodySize)
+		}
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	sum := sha256.Sum256(body)
+	if !hmac.Equal([]byte(digest), []byte(hex.EncodeToString(sum[:]))) {
+		return ErrBodyMismatch
+	}
+	return nil
+}
+
+func signature(key []byte, method, uri, service, timestamp, digest string) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%s", method, uri, service, timestamp, digest)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// transport подписывает каждый исходящий запрос
+type transport struct {
+	service string
+	key     []byte
+	base    http.RoundTripper
+}
+
+// NewTransport транспорт сервиса service поверх base, подписывающий все
+// запросы ключом key
+func NewTransport(service string, key []byte, base http.RoundTripper) http.RoundTripper {
+	return &transport{service: service, key: key, base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper не должен изменять исходный запрос
+	signed := req.Clone(req.Context())
+	if err := Sign(signed, t.service, t.key, time.Now()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.base.RoundTrip(signed)
+}
+
+// Middleware пропускает только запросы, подписанные ключом key сервисами из
+// allowed. Маршруты public доступны без подписи.
+func Middleware(key []byte, allowed []string, public ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if slices.Contains(public, ctx.Path()) {
+				return next(ctx)
+			}
+
+			service, err := Verify(ctx.Request(), key, time.Now())
+			if err != nil {
+				return ctx.JSON(http.StatusUnauthorized, map[string]string{
+					"error":   "UNAUTHORIZED_SERVICE",
+					"message": err.Error(),
+				})
+			}
+			if !slices.Contains(allowed, service) {
+				return ctx.JSON(http.StatusForbidden, map[string]string{
+					"error":   "FORBIDDEN_SERVICE",
+					"message": fmt.Sprintf("%v: %s", ErrServiceNotAllowed, service),
+				})
+			}
+			return next(ctx)
+		}
+	}
+}